@@ -0,0 +1,95 @@
+package jira
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+
+	"github.com/aaronmaturen/go-jira/jira/adf"
+)
+
+// ADFToText returns the readable text of a rich text value, such as
+// IssueFields.Description, IssueFields.Environment or Comment.Body. The value
+// may be an ADF document as decoded from the API, an *adf.Node, or a plain
+// string, which is returned unchanged; nil gives an empty string. Block nodes
+// are separated by newlines as described in adf.PlainText.
+//
+// It returns an error only if v is not ADF-shaped: a JSON object with a type.
+func ADFToText(v any) (string, error) {
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	node, err := adfNode(v)
+	if err != nil {
+		return "", err
+	}
+	return adf.PlainText(node), nil
+}
+
+// ADFToMarkdown renders a rich text value as markdown, with tables as
+// pipe-delimited rows, as described in adf.Markdown. It accepts the same
+// values as ADFToText; a plain string is returned unchanged.
+func ADFToMarkdown(v any) (string, error) {
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	node, err := adfNode(v)
+	if err != nil {
+		return "", err
+	}
+	return adf.Markdown(node), nil
+}
+
+// ADFToHTML renders a rich text value as an HTML fragment, as described in
+// adf.HTML. It accepts the same values as ADFToText; a plain string is
+// returned HTML-escaped.
+func ADFToHTML(v any) (string, error) {
+	if s, ok := v.(string); ok {
+		return html.EscapeString(s), nil
+	}
+	node, err := adfNode(v)
+	if err != nil {
+		return "", err
+	}
+	return adf.HTML(node), nil
+}
+
+// adfNode returns v, a rich text value other than a string, as an ADF node.
+// nil gives a nil node.
+func adfNode(v any) (*adf.Node, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case *adf.Node:
+		return v, nil
+	case adf.Node:
+		return &v, nil
+	}
+
+	data, ok := v.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return nil, fmt.Errorf("not an ADF value: %w", err)
+		}
+	}
+	var node adf.Node
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf("not an ADF value: %w", err)
+	}
+	if node.Type == "" {
+		return nil, errors.New("not an ADF value: missing node type")
+	}
+	return &node, nil
+}
+
+// adfBody returns v as a rich text request value: a string becomes an ADF
+// document, since the v3 API rejects plain text bodies, and anything else is
+// returned unchanged.
+func adfBody(v any) any {
+	if text, ok := v.(string); ok {
+		return adf.FromText(text)
+	}
+	return v
+}
@@ -0,0 +1,318 @@
+// Package adf builds documents in the Atlassian Document Format, the rich
+// text format Jira uses for issue descriptions, environments, comments and
+// worklog comments.
+//
+//	doc, err := adf.NewDocument().
+//		Heading(2, "Build failed").
+//		Paragraph("Assigning to ").Mention("5b10a2844c20165700ede21g", "Mia").
+//		Bullet("lint", "unit tests").
+//		CodeBlock("go", "panic: nil map").
+//		Build()
+//
+// The returned *Node can be used anywhere the jira package accepts an ADF
+// value, such as IssueFields.Description or a comment body.
+package adf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Node types.
+const (
+	TypeDoc         = "doc"
+	TypeParagraph   = "paragraph"
+	TypeHeading     = "heading"
+	TypeBulletList  = "bulletList"
+	TypeOrderedList = "orderedList"
+	TypeListItem    = "listItem"
+	TypeCodeBlock   = "codeBlock"
+	TypeBlockquote  = "blockquote"
+	TypeRule        = "rule"
+	TypeTable       = "table"
+	TypeTableRow    = "tableRow"
+	TypeTableHeader = "tableHeader"
+	TypeTableCell   = "tableCell"
+	TypeText        = "text"
+	TypeMention     = "mention"
+	TypeHardBreak   = "hardBreak"
+)
+
+// Mark types.
+const (
+	MarkStrong = "strong"
+	MarkEm     = "em"
+	MarkCode   = "code"
+	MarkLink   = "link"
+	MarkStrike = "strike"
+)
+
+// Node is an ADF node. A document is a Node of type "doc" with Version 1.
+type Node struct {
+	Version int            `json:"version,omitempty"`
+	Type    string         `json:"type"`
+	Attrs   map[string]any `json:"attrs,omitempty"`
+	Content []*Node        `json:"content,omitempty"`
+	Text    string         `json:"text,omitempty"`
+	Marks   []*Mark        `json:"marks,omitempty"`
+}
+
+// MarshalJSON encodes the node, always including the content array of a
+// document, which ADF requires even when empty.
+func (n *Node) MarshalJSON() ([]byte, error) {
+	type node Node
+	if n.Type == TypeDoc && n.Content == nil {
+		return json.Marshal(&struct {
+			*node
+			Content []*Node `json:"content"`
+		}{node: (*node)(n), Content: []*Node{}})
+	}
+	return json.Marshal((*node)(n))
+}
+
+// Mark is formatting applied to a text node.
+type Mark struct {
+	Type  string         `json:"type"`
+	Attrs map[string]any `json:"attrs,omitempty"`
+}
+
+// Text returns a text node with the given marks.
+func Text(text string, marks ...*Mark) *Node {
+	return &Node{Type: TypeText, Text: text, Marks: marks}
+}
+
+// Strong returns bold text.
+func Strong(text string) *Node { return Text(text, &Mark{Type: MarkStrong}) }
+
+// Em returns italic text.
+func Em(text string) *Node { return Text(text, &Mark{Type: MarkEm}) }
+
+// Code returns inline code.
+func Code(text string) *Node { return Text(text, &Mark{Type: MarkCode}) }
+
+// Link returns text linking to href.
+func Link(text, href string) *Node {
+	return Text(text, &Mark{Type: MarkLink, Attrs: map[string]any{"href": href}})
+}
+
+// Mention returns a mention of a user. text is shown if the user can't be
+// resolved and may be empty.
+func Mention(accountID, text string) *Node {
+	attrs := map[string]any{"id": accountID}
+	if text != "" {
+		attrs["text"] = "@" + text
+	}
+	return &Node{Type: TypeMention, Attrs: attrs}
+}
+
+// HardBreak returns a line break within a paragraph.
+func HardBreak() *Node { return &Node{Type: TypeHardBreak} }
+
+// TableRow returns a table row of header or data cells.
+func TableRow(cells ...*Node) *Node {
+	return &Node{Type: TypeTableRow, Content: cells}
+}
+
+// TableHeader returns a header cell containing text.
+func TableHeader(text string) *Node {
+	return &Node{Type: TypeTableHeader, Content: []*Node{paragraph(text)}}
+}
+
+// TableCell returns a data cell containing text.
+func TableCell(text string) *Node {
+	return &Node{Type: TypeTableCell, Content: []*Node{paragraph(text)}}
+}
+
+// FromText returns a document holding plain text. Blank lines separate
+// paragraphs and other line breaks become hard breaks, so the text reads the
+// same once Jira renders it.
+func FromText(text string) *Node {
+	doc := &Node{Version: 1, Type: TypeDoc}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	for _, block := range strings.Split(text, "\n\n") {
+		block = strings.Trim(block, "\n")
+		if block == "" {
+			continue
+		}
+		para := &Node{Type: TypeParagraph}
+		for i, line := range strings.Split(block, "\n") {
+			if i > 0 {
+				para.Content = append(para.Content, HardBreak())
+			}
+			para.Content = append(para.Content, textContent(line)...)
+		}
+		doc.Content = append(doc.Content, para)
+	}
+	return doc
+}
+
+// Document builds an ADF document. Each method appends to the document and
+// returns it for chaining. Problems such as an invalid heading level are
+// reported by Build.
+type Document struct {
+	content []*Node
+	err     error
+}
+
+// NewDocument returns an empty document builder.
+func NewDocument() *Document {
+	return &Document{}
+}
+
+// Paragraph appends a paragraph of plain text.
+func (d *Document) Paragraph(text string) *Document {
+	d.content = append(d.content, paragraph(text))
+	return d
+}
+
+// ParagraphOf appends a paragraph of inline nodes, such as those returned by
+// Text, Link and Mention.
+func (d *Document) ParagraphOf(inline ...*Node) *Document {
+	d.content = append(d.content, &Node{Type: TypeParagraph, Content: inline})
+	return d
+}
+
+// Text appends text to the last paragraph, starting one if needed.
+func (d *Document) Text(text string, marks ...*Mark) *Document {
+	return d.inline(Text(text, marks...))
+}
+
+// Link appends a link to the last paragraph, starting one if needed.
+func (d *Document) Link(text, href string) *Document {
+	return d.inline(Link(text, href))
+}
+
+// Mention appends a mention of a user to the last paragraph, starting one if
+// needed.
+func (d *Document) Mention(accountID, text string) *Document {
+	if accountID == "" {
+		d.fail(errors.New("adf: mention requires an account ID"))
+		return d
+	}
+	return d.inline(Mention(accountID, text))
+}
+
+// Heading appends a heading of the given level, from 1 to 6.
+func (d *Document) Heading(level int, text string) *Document {
+	if level < 1 || level > 6 {
+		d.fail(fmt.Errorf("adf: heading level %d out of range 1-6", level))
+		return d
+	}
+	d.content = append(d.content, &Node{
+		Type:    TypeHeading,
+		Attrs:   map[string]any{"level": level},
+		Content: textContent(text),
+	})
+	return d
+}
+
+// Bullet appends a bulleted list with one plain-text item per argument.
+func (d *Document) Bullet(items ...string) *Document {
+	d.content = append(d.content, list(TypeBulletList, items))
+	return d
+}
+
+// Ordered appends a numbered list with one plain-text item per argument.
+func (d *Document) Ordered(items ...string) *Document {
+	d.content = append(d.content, list(TypeOrderedList, items))
+	return d
+}
+
+// CodeBlock appends a block of code. language may be empty.
+func (d *Document) CodeBlock(language, code string) *Document {
+	node := &Node{Type: TypeCodeBlock, Content: textContent(code)}
+	if language != "" {
+		node.Attrs = map[string]any{"language": language}
+	}
+	d.content = append(d.content, node)
+	return d
+}
+
+// Blockquote appends a quoted paragraph of text.
+func (d *Document) Blockquote(text string) *Document {
+	d.content = append(d.content, &Node{Type: TypeBlockquote, Content: []*Node{paragraph(text)}})
+	return d
+}
+
+// Rule appends a horizontal rule.
+func (d *Document) Rule() *Document {
+	d.content = append(d.content, &Node{Type: TypeRule})
+	return d
+}
+
+// Table appends a table built from rows returned by TableRow. Every row must
+// have the same number of cells.
+func (d *Document) Table(rows ...*Node) *Document {
+	if len(rows) == 0 {
+		d.fail(errors.New("adf: table has no rows"))
+		return d
+	}
+	for i, row := range rows {
+		if row == nil || row.Type != TypeTableRow {
+			d.fail(fmt.Errorf("adf: table row %d is not a tableRow", i))
+			return d
+		}
+		if len(row.Content) != len(rows[0].Content) {
+			d.fail(fmt.Errorf("adf: table row %d has %d cells, want %d", i, len(row.Content), len(rows[0].Content)))
+			return d
+		}
+	}
+	d.content = append(d.content, &Node{
+		Type:    TypeTable,
+		Attrs:   map[string]any{"isNumberColumnEnabled": false, "layout": "default"},
+		Content: rows,
+	})
+	return d
+}
+
+// Append appends block nodes built elsewhere.
+func (d *Document) Append(blocks ...*Node) *Document {
+	d.content = append(d.content, blocks...)
+	return d
+}
+
+// Build returns the document, or the first problem found while building it.
+func (d *Document) Build() (*Node, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	return &Node{Version: 1, Type: TypeDoc, Content: d.content}, nil
+}
+
+// inline appends node to the last block if it is a paragraph, or to a new one.
+func (d *Document) inline(node *Node) *Document {
+	if n := len(d.content); n > 0 && d.content[n-1].Type == TypeParagraph {
+		d.content[n-1].Content = append(d.content[n-1].Content, node)
+		return d
+	}
+	return d.ParagraphOf(node)
+}
+
+func (d *Document) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+func paragraph(text string) *Node {
+	return &Node{Type: TypeParagraph, Content: textContent(text)}
+}
+
+// textContent returns a text node for text, or nothing for an empty string,
+// which ADF doesn't allow in text nodes.
+func textContent(text string) []*Node {
+	if text == "" {
+		return nil
+	}
+	return []*Node{Text(text)}
+}
+
+func list(listType string, items []string) *Node {
+	node := &Node{Type: listType}
+	for _, item := range items {
+		node.Content = append(node.Content, &Node{Type: TypeListItem, Content: []*Node{paragraph(item)}})
+	}
+	return node
+}
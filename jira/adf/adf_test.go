@@ -0,0 +1,131 @@
+package adf
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// apiDocument is an issue description as returned by the Jira Cloud API.
+const apiDocument = `{
+	"version": 1,
+	"type": "doc",
+	"content": [
+		{"type": "heading", "attrs": {"level": 2}, "content": [{"type": "text", "text": "Steps"}]},
+		{"type": "paragraph", "content": [
+			{"type": "text", "text": "Reported by "},
+			{"type": "mention", "attrs": {"id": "5b10a2844c20165700ede21g", "text": "@Mia Krystof", "accessLevel": ""}},
+			{"type": "text", "text": ", see "},
+			{"type": "text", "text": "the docs", "marks": [{"type": "link", "attrs": {"href": "https://example.com"}}]}
+		]},
+		{"type": "orderedList", "attrs": {"order": 1}, "content": [
+			{"type": "listItem", "content": [{"type": "paragraph", "content": [{"type": "text", "text": "Log in"}]}]}
+		]},
+		{"type": "codeBlock", "attrs": {"language": "go"}, "content": [{"type": "text", "text": "panic(err)"}]},
+		{"type": "table", "attrs": {"isNumberColumnEnabled": false, "layout": "default"}, "content": [
+			{"type": "tableRow", "content": [
+				{"type": "tableHeader", "content": [{"type": "paragraph", "content": [{"type": "text", "text": "Test", "marks": [{"type": "strong"}]}]}]}
+			]}
+		]}
+	]
+}`
+
+func TestNode_RoundTrip(t *testing.T) {
+	var doc Node
+	if err := json.Unmarshal([]byte(apiDocument), &doc); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if doc.Type != TypeDoc || doc.Version != 1 || len(doc.Content) != 5 {
+		t.Fatalf("doc = %+v", doc)
+	}
+
+	data, err := json.Marshal(&doc)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	var got, want any
+	json.Unmarshal(data, &got)
+	json.Unmarshal([]byte(apiDocument), &want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip = %s", data)
+	}
+}
+
+func TestDocument_Build(t *testing.T) {
+	doc, err := NewDocument().
+		Heading(2, "Steps").
+		Paragraph("Reported by ").Mention("abc", "Mia").Text(", see ").Link("the docs", "https://example.com").
+		Bullet("one", "two").
+		CodeBlock("go", "panic(err)").
+		Table(
+			TableRow(TableHeader("Test"), TableHeader("Result")),
+			TableRow(TableCell("login"), TableCell("pass")),
+		).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	want := `{"version":1,"type":"doc","content":[` +
+		`{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Steps"}]},` +
+		`{"type":"paragraph","content":[{"type":"text","text":"Reported by "},{"type":"mention","attrs":{"id":"abc","text":"@Mia"}},{"type":"text","text":", see "},{"type":"text","text":"the docs","marks":[{"type":"link","attrs":{"href":"https://example.com"}}]}]},` +
+		`{"type":"bulletList","content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"one"}]}]},{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"two"}]}]}]},` +
+		`{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"panic(err)"}]},` +
+		`{"type":"table","attrs":{"isNumberColumnEnabled":false,"layout":"default"},"content":[` +
+		`{"type":"tableRow","content":[{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Test"}]}]},{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Result"}]}]}]},` +
+		`{"type":"tableRow","content":[{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"login"}]}]},{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"pass"}]}]}]}]}]}`
+	if string(data) != want {
+		t.Errorf("Build() =\n%s\nwant\n%s", data, want)
+	}
+}
+
+func TestDocument_BuildErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  *Document
+	}{
+		{"heading level", NewDocument().Heading(7, "Too deep")},
+		{"empty mention", NewDocument().Mention("", "Nobody")},
+		{"ragged table", NewDocument().Table(
+			TableRow(TableHeader("A"), TableHeader("B")),
+			TableRow(TableCell("1")),
+		)},
+		{"empty table", NewDocument().Table()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.doc.Build(); err == nil {
+				t.Error("Build() expected error")
+			}
+		})
+	}
+}
+
+func TestDocument_BuildEmpty(t *testing.T) {
+	doc, err := NewDocument().Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	data, _ := json.Marshal(doc)
+	if string(data) != `{"version":1,"type":"doc","content":[]}` {
+		t.Errorf("Build() = %s", data)
+	}
+}
+
+func TestFromText(t *testing.T) {
+	data, _ := json.Marshal(FromText("First line\nsecond line\r\n\r\n\nNext paragraph\n"))
+	want := `{"version":1,"type":"doc","content":[` +
+		`{"type":"paragraph","content":[{"type":"text","text":"First line"},{"type":"hardBreak"},{"type":"text","text":"second line"}]},` +
+		`{"type":"paragraph","content":[{"type":"text","text":"Next paragraph"}]}]}`
+	if string(data) != want {
+		t.Errorf("FromText() =\n%s\nwant\n%s", data, want)
+	}
+
+	if got := PlainText(FromText("a\nb\n\nc")); got != "a\nb\nc" {
+		t.Errorf("PlainText(FromText()) = %q, want %q", got, "a\nb\nc")
+	}
+}
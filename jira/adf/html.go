@@ -0,0 +1,119 @@
+package adf
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// HTML renders a node as an HTML fragment, for mail bodies and other places
+// that take HTML rather than ADF. Text and attribute values are escaped, and
+// links are kept only for http, https and mailto URLs. Node types it doesn't
+// know are rendered through their content.
+func HTML(n *Node) string {
+	if n == nil {
+		return ""
+	}
+	var b strings.Builder
+	writeHTML(&b, n)
+	return b.String()
+}
+
+// writeHTML writes a node and its content.
+func writeHTML(b *strings.Builder, n *Node) {
+	switch n.Type {
+	case TypeText:
+		writeHTMLText(b, n.Text, n.Marks)
+	case TypeHardBreak:
+		b.WriteString("<br>")
+	case TypeMention, "emoji", "inlineCard":
+		b.WriteString(html.EscapeString(inlineText(n)))
+	case TypeHeading:
+		level := 1
+		switch l := n.Attrs["level"].(type) {
+		case float64:
+			level = int(l)
+		case int:
+			level = l
+		}
+		level = min(max(level, 1), 6)
+		fmt.Fprintf(b, "<h%d>", level)
+		writeHTMLContent(b, n)
+		fmt.Fprintf(b, "</h%d>", level)
+	case TypeCodeBlock:
+		b.WriteString("<pre><code>" + html.EscapeString(inlineText(n)) + "</code></pre>")
+	case TypeRule:
+		b.WriteString("<hr>")
+	case TypeOrderedList:
+		b.WriteString("<ol")
+		switch order := n.Attrs["order"].(type) {
+		case float64:
+			fmt.Fprintf(b, ` start="%d"`, int(order))
+		case int:
+			fmt.Fprintf(b, ` start="%d"`, order)
+		}
+		b.WriteString(">")
+		writeHTMLContent(b, n)
+		b.WriteString("</ol>")
+	default:
+		tag := htmlTags[n.Type]
+		if tag != "" {
+			b.WriteString("<" + tag + ">")
+		}
+		writeHTMLContent(b, n)
+		if tag != "" {
+			b.WriteString("</" + tag + ">")
+		}
+	}
+}
+
+// htmlTags maps the node types that render as a plain element to its tag.
+var htmlTags = map[string]string{
+	TypeParagraph:   "p",
+	TypeBulletList:  "ul",
+	TypeListItem:    "li",
+	TypeBlockquote:  "blockquote",
+	TypeTable:       "table",
+	TypeTableRow:    "tr",
+	TypeTableHeader: "th",
+	TypeTableCell:   "td",
+}
+
+func writeHTMLContent(b *strings.Builder, n *Node) {
+	for _, child := range n.Content {
+		writeHTML(b, child)
+	}
+}
+
+// writeHTMLText writes escaped text wrapped in the elements for its marks.
+func writeHTMLText(b *strings.Builder, text string, marks []*Mark) {
+	text = html.EscapeString(text)
+	for _, m := range marks {
+		switch m.Type {
+		case MarkCode:
+			text = "<code>" + text + "</code>"
+		case MarkEm:
+			text = "<em>" + text + "</em>"
+		case MarkStrong:
+			text = "<strong>" + text + "</strong>"
+		case MarkStrike:
+			text = "<s>" + text + "</s>"
+		case MarkLink:
+			if href, _ := m.Attrs["href"].(string); isSafeHref(href) {
+				text = `<a href="` + html.EscapeString(href) + `">` + text + "</a>"
+			}
+		}
+	}
+	b.WriteString(text)
+}
+
+// isSafeHref reports whether href uses a scheme that is safe to link to.
+func isSafeHref(href string) bool {
+	lower := strings.ToLower(strings.TrimSpace(href))
+	for _, scheme := range []string{"http://", "https://", "mailto:"} {
+		if strings.HasPrefix(lower, scheme) {
+			return true
+		}
+	}
+	return false
+}
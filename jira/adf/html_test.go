@@ -0,0 +1,43 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestHTML(t *testing.T) {
+	var doc Node
+	if err := json.Unmarshal([]byte(apiDocument), &doc); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	want := "<h2>Steps</h2>" +
+		`<p>Reported by @Mia Krystof, see <a href="https://example.com">the docs</a></p>` +
+		`<ol start="1"><li><p>Log in</p></li></ol>` +
+		"<pre><code>panic(err)</code></pre>" +
+		"<table><tr><th><p><strong>Test</strong></p></th></tr></table>"
+	if got := HTML(&doc); got != want {
+		t.Errorf("HTML() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestHTML_Escaping(t *testing.T) {
+	doc, err := NewDocument().
+		ParagraphOf(Strong("<b>"), Text(" & "), Em("em"), HardBreak(), Code("a < b")).
+		Paragraph("").Link("click", "javascript:alert(1)").
+		Bullet("one").
+		Rule().
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	want := "<p><strong>&lt;b&gt;</strong> &amp; <em>em</em><br><code>a &lt; b</code></p>" +
+		"<p>click</p>" +
+		"<ul><li><p>one</p></li></ul>" +
+		"<hr>"
+	if got := HTML(doc); got != want {
+		t.Errorf("HTML() =\n%s\nwant\n%s", got, want)
+	}
+	if got := HTML(nil); got != "" {
+		t.Errorf("HTML(nil) = %q, want empty", got)
+	}
+}
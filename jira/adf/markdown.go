@@ -0,0 +1,153 @@
+package adf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Markdown renders a node as CommonMark with GitHub-style tables. Blocks are
+// separated by blank lines; tables become pipe-delimited rows with a
+// separator after the first row, as markdown requires a header row. Marks
+// are rendered as **strong**, _em_, `code`, ~~strike~~ and [links](href).
+// Node types it doesn't know are rendered through their content.
+func Markdown(n *Node) string {
+	if n == nil {
+		return ""
+	}
+	return markdownBlock(n)
+}
+
+// markdownBlock renders a block node without a trailing newline.
+func markdownBlock(n *Node) string {
+	switch n.Type {
+	case TypeParagraph:
+		return markdownInline(n.Content)
+	case TypeHeading:
+		level := 1
+		switch l := n.Attrs["level"].(type) {
+		case float64:
+			level = int(l)
+		case int:
+			level = l
+		}
+		level = min(max(level, 1), 6)
+		return strings.Repeat("#", level) + " " + markdownInline(n.Content)
+	case TypeCodeBlock:
+		language, _ := n.Attrs["language"].(string)
+		return "```" + language + "\n" + inlineText(n) + "\n```"
+	case TypeBlockquote:
+		return prefixLines(markdownBlocks(n.Content, "\n\n"), "> ", "> ")
+	case TypeRule:
+		return "---"
+	case TypeBulletList, TypeOrderedList:
+		number := 1
+		switch order := n.Attrs["order"].(type) {
+		case float64:
+			number = int(order)
+		case int:
+			number = order
+		}
+		items := make([]string, len(n.Content))
+		for i, item := range n.Content {
+			marker := "- "
+			if n.Type == TypeOrderedList {
+				marker = fmt.Sprintf("%d. ", number)
+				number++
+			}
+			items[i] = prefixLines(markdownBlocks(item.Content, "\n"), marker, strings.Repeat(" ", len(marker)))
+		}
+		return strings.Join(items, "\n")
+	case TypeTable:
+		rows := make([]string, 0, len(n.Content)+1)
+		for i, row := range n.Content {
+			cells := make([]string, len(row.Content))
+			for j, cell := range row.Content {
+				text := strings.ReplaceAll(markdownBlocks(cell.Content, " "), "\n", " ")
+				cells[j] = strings.ReplaceAll(text, "|", `\|`)
+			}
+			rows = append(rows, "| "+strings.Join(cells, " | ")+" |")
+			if i == 0 {
+				rows = append(rows, "|"+strings.Repeat(" --- |", len(cells)))
+			}
+		}
+		return strings.Join(rows, "\n")
+	default:
+		if hasBlockContent(n) {
+			return markdownBlocks(n.Content, "\n\n")
+		}
+		return markdownInline([]*Node{n})
+	}
+}
+
+// markdownBlocks renders block nodes joined by sep, skipping empty ones.
+func markdownBlocks(nodes []*Node, sep string) string {
+	blocks := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		if block := markdownBlock(n); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	return strings.Join(blocks, sep)
+}
+
+// markdownInline renders inline nodes with their marks.
+func markdownInline(nodes []*Node) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		switch n.Type {
+		case TypeText:
+			b.WriteString(markdownMarks(n.Text, n.Marks))
+		case TypeHardBreak:
+			b.WriteString("\\\n")
+		case TypeMention, "emoji", "inlineCard":
+			b.WriteString(inlineText(n))
+		default:
+			b.WriteString(markdownInline(n.Content))
+		}
+	}
+	return b.String()
+}
+
+// markdownMarks wraps text in the markdown for its marks.
+func markdownMarks(text string, marks []*Mark) string {
+	if text == "" {
+		return ""
+	}
+	var href string
+	for _, m := range marks {
+		switch m.Type {
+		case MarkCode:
+			text = "`" + text + "`"
+		case MarkEm:
+			text = "_" + text + "_"
+		case MarkStrong:
+			text = "**" + text + "**"
+		case MarkStrike:
+			text = "~~" + text + "~~"
+		case MarkLink:
+			href, _ = m.Attrs["href"].(string)
+		}
+	}
+	if href != "" {
+		text = "[" + text + "](" + href + ")"
+	}
+	return text
+}
+
+// prefixLines puts first before the first line of text and rest before the
+// others; blank lines only get the prefix's trailing space trimmed.
+func prefixLines(text, first, rest string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		prefix := rest
+		if i == 0 {
+			prefix = first
+		}
+		if line == "" {
+			lines[i] = strings.TrimRight(prefix, " ")
+		} else {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
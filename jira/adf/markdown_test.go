@@ -0,0 +1,48 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarkdown(t *testing.T) {
+	var doc Node
+	if err := json.Unmarshal([]byte(apiDocument), &doc); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	want := "## Steps\n\n" +
+		"Reported by @Mia Krystof, see [the docs](https://example.com)\n\n" +
+		"1. Log in\n\n" +
+		"```go\npanic(err)\n```\n\n" +
+		"| **Test** |\n| --- |"
+	if got := Markdown(&doc); got != want {
+		t.Errorf("Markdown() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestMarkdown_Blocks(t *testing.T) {
+	doc, err := NewDocument().
+		Bullet("one", "two").
+		Blockquote("quoted").
+		Rule().
+		ParagraphOf(Strong("bold"), Text(" and "), Em("em"), HardBreak(), Code("x := 1")).
+		Table(
+			TableRow(TableHeader("Test"), TableHeader("Result")),
+			TableRow(TableCell("a|b"), TableCell("pass")),
+		).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	want := "- one\n- two\n\n" +
+		"> quoted\n\n" +
+		"---\n\n" +
+		"**bold** and _em_\\\n`x := 1`\n\n" +
+		"| Test | Result |\n| --- | --- |\n| a\\|b | pass |"
+	if got := Markdown(doc); got != want {
+		t.Errorf("Markdown() =\n%s\nwant\n%s", got, want)
+	}
+	if got := Markdown(nil); got != "" {
+		t.Errorf("Markdown(nil) = %q, want empty", got)
+	}
+}
@@ -0,0 +1,141 @@
+package adf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PlainText returns the readable text of a node. Block nodes are separated by
+// newlines, list items are prefixed with "- " or their number, and table
+// cells are separated by " | ". Node types it doesn't know are rendered
+// through their content, so no text is lost.
+func PlainText(n *Node) string {
+	if n == nil {
+		return ""
+	}
+	var b strings.Builder
+	writeBlock(&b, n, "")
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// writeBlock writes a block node followed by a newline. prefix starts every
+// line, for indentation inside lists and quotes.
+func writeBlock(b *strings.Builder, n *Node, prefix string) {
+	switch n.Type {
+	case TypeDoc:
+		for _, child := range n.Content {
+			writeBlock(b, child, prefix)
+		}
+	case TypeBulletList, TypeOrderedList:
+		number := 1
+		switch order := n.Attrs["order"].(type) {
+		case float64:
+			number = int(order)
+		case int:
+			number = order
+		}
+		for _, item := range n.Content {
+			marker := "- "
+			if n.Type == TypeOrderedList {
+				marker = fmt.Sprintf("%d. ", number)
+				number++
+			}
+			writeListItem(b, item, prefix, marker)
+		}
+	case TypeBlockquote:
+		for _, child := range n.Content {
+			writeBlock(b, child, prefix+"> ")
+		}
+	case TypeRule:
+		b.WriteString(prefix + "---\n")
+	case TypeTable:
+		for _, row := range n.Content {
+			cells := make([]string, len(row.Content))
+			for i, cell := range row.Content {
+				cells[i] = strings.ReplaceAll(PlainText(cell), "\n", " ")
+			}
+			b.WriteString(prefix + strings.Join(cells, " | ") + "\n")
+		}
+	case TypeParagraph, TypeHeading, TypeCodeBlock:
+		writeLines(b, inlineText(n), prefix)
+	default:
+		if hasBlockContent(n) {
+			for _, child := range n.Content {
+				writeBlock(b, child, prefix)
+			}
+		} else if text := inlineText(n); text != "" {
+			writeLines(b, text, prefix)
+		}
+	}
+}
+
+// writeListItem writes a list item with marker before its first line and
+// matching indentation before the rest.
+func writeListItem(b *strings.Builder, item *Node, prefix, marker string) {
+	var inner strings.Builder
+	for _, child := range item.Content {
+		writeBlock(&inner, child, "")
+	}
+	indent := strings.Repeat(" ", len(marker))
+	for i, line := range strings.Split(strings.TrimRight(inner.String(), "\n"), "\n") {
+		if i == 0 {
+			b.WriteString(prefix + marker + line + "\n")
+		} else {
+			b.WriteString(prefix + indent + line + "\n")
+		}
+	}
+}
+
+func writeLines(b *strings.Builder, text, prefix string) {
+	for _, line := range strings.Split(text, "\n") {
+		b.WriteString(prefix + line + "\n")
+	}
+}
+
+// inlineText concatenates the inline content of a node.
+func inlineText(n *Node) string {
+	var b strings.Builder
+	var walk func(*Node)
+	walk = func(n *Node) {
+		switch n.Type {
+		case TypeText:
+			b.WriteString(n.Text)
+		case TypeHardBreak:
+			b.WriteString("\n")
+		case TypeMention:
+			if text, ok := n.Attrs["text"].(string); ok && text != "" {
+				b.WriteString(text)
+			} else if id, ok := n.Attrs["id"].(string); ok {
+				b.WriteString("@" + id)
+			}
+		case "emoji":
+			if text, ok := n.Attrs["text"].(string); ok {
+				b.WriteString(text)
+			} else if name, ok := n.Attrs["shortName"].(string); ok {
+				b.WriteString(name)
+			}
+		case "inlineCard", "blockCard":
+			if url, ok := n.Attrs["url"].(string); ok {
+				b.WriteString(url)
+			}
+		default:
+			for _, child := range n.Content {
+				walk(child)
+			}
+		}
+	}
+	walk(n)
+	return b.String()
+}
+
+// hasBlockContent reports whether any child of n is a block node.
+func hasBlockContent(n *Node) bool {
+	for _, child := range n.Content {
+		switch child.Type {
+		case TypeText, TypeHardBreak, TypeMention, "emoji", "inlineCard":
+		default:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aaronmaturen/go-jira/jira/adf"
+)
+
+func TestADFToText(t *testing.T) {
+	input := `{"fields":{"description":{"version":1,"type":"doc","content":[
+		{"type":"heading","attrs":{"level":1},"content":[{"type":"text","text":"Summary"}]},
+		{"type":"paragraph","content":[{"type":"text","text":"Hello "},{"type":"mention","attrs":{"id":"abc","text":"@Mia"}},{"type":"hardBreak"},{"type":"text","text":"bye","marks":[{"type":"strong"}]}]},
+		{"type":"bulletList","content":[
+			{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"one"}]},
+				{"type":"orderedList","attrs":{"order":3},"content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"nested"}]}]}]}]},
+			{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"two"}]}]}
+		]},
+		{"type":"table","content":[
+			{"type":"tableRow","content":[{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Test"}]}]},{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Result"}]}]}]},
+			{"type":"tableRow","content":[{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"login"}]}]},{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"pass"}]}]}]}
+		]}
+	]}}}`
+
+	var issue Issue
+	if err := json.Unmarshal([]byte(input), &issue); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	got, err := ADFToText(issue.Fields.Description)
+	if err != nil {
+		t.Fatalf("ADFToText() error = %v", err)
+	}
+	want := "Summary\nHello @Mia\nbye\n- one\n  3. nested\n- two\nTest | Result\nlogin | pass"
+	if got != want {
+		t.Errorf("ADFToText() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestADFToText_Values(t *testing.T) {
+	doc, _ := adf.NewDocument().Paragraph("built").Build()
+	tests := []struct {
+		name    string
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{"plain string", "already text", "already text", false},
+		{"nil", nil, "", false},
+		{"node", doc, "built", false},
+		{"raw", json.RawMessage(`{"type":"paragraph","content":[{"type":"text","text":"raw"}]}`), "raw", false},
+		{"number", 42, "", true},
+		{"object without type", map[string]any{"foo": "bar"}, "", true},
+		{"array", []any{"a"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ADFToText(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ADFToText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ADFToText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestADFToMarkdown(t *testing.T) {
+	doc, _ := adf.NewDocument().
+		Heading(3, "Results").
+		Table(
+			adf.TableRow(adf.TableHeader("Test"), adf.TableHeader("Result")),
+			adf.TableRow(adf.TableCell("login"), adf.TableCell("pass")),
+		).
+		Build()
+	data, _ := json.Marshal(doc)
+
+	got, err := ADFToMarkdown(json.RawMessage(data))
+	if err != nil {
+		t.Fatalf("ADFToMarkdown() error = %v", err)
+	}
+	want := "### Results\n\n| Test | Result |\n| --- | --- |\n| login | pass |"
+	if got != want {
+		t.Errorf("ADFToMarkdown() =\n%s\nwant\n%s", got, want)
+	}
+
+	if got, _ := ADFToMarkdown("plain"); got != "plain" {
+		t.Errorf("ADFToMarkdown(string) = %q, want %q", got, "plain")
+	}
+	if _, err := ADFToMarkdown(42); err == nil {
+		t.Error("ADFToMarkdown(42) error = nil, want error")
+	}
+}
+
+func TestADFToHTML(t *testing.T) {
+	doc, _ := adf.NewDocument().Heading(3, "Results").Paragraph("a < b").Build()
+	data, _ := json.Marshal(doc)
+
+	got, err := ADFToHTML(json.RawMessage(data))
+	if err != nil {
+		t.Fatalf("ADFToHTML() error = %v", err)
+	}
+	if want := "<h3>Results</h3><p>a &lt; b</p>"; got != want {
+		t.Errorf("ADFToHTML() = %v, want %v", got, want)
+	}
+
+	if got, _ := ADFToHTML("a & b"); got != "a &amp; b" {
+		t.Errorf("ADFToHTML(string) = %q, want %q", got, "a &amp; b")
+	}
+	if _, err := ADFToHTML(42); err == nil {
+		t.Error("ADFToHTML(42) error = nil, want error")
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 // AttachmentsService handles attachment operations for the Jira API.
@@ -142,7 +144,7 @@ func (s *AttachmentsService) Download(ctx context.Context, attachmentID string)
 		return nil, nil, err
 	}
 
-	resp, err := s.client.client.Do(req)
+	resp, err := s.client.send(req)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -150,6 +152,51 @@ func (s *AttachmentsService) Download(ctx context.Context, attachmentID string)
 	return resp.Body, newResponse(resp), nil
 }
 
+// DownloadContent copies the content of an attachment to w as it arrives,
+// without holding the whole file in memory. Jira redirects to the file's
+// storage location, which the HTTP client follows. An error status is
+// returned as an *ErrorResponse, and nothing is written to w. The client's
+// timeout doesn't apply, as a large file can take longer to copy; bound the
+// download with ctx.
+func (s *AttachmentsService) DownloadContent(ctx context.Context, attachmentID string, w io.Writer) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/attachment/content/%s", attachmentID)
+	return s.download(ctx, u, w)
+}
+
+// DownloadThumbnail copies the thumbnail of an attachment to w, as
+// DownloadContent does for its content. Width and height, if greater than
+// zero, set the largest thumbnail size. With fallbackToDefault, Jira returns a
+// default image for attachments that have no thumbnail.
+func (s *AttachmentsService) DownloadThumbnail(ctx context.Context, attachmentID string, width, height int, fallbackToDefault bool, w io.Writer) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/attachment/thumbnail/%s", attachmentID)
+
+	params := url.Values{}
+	if width > 0 {
+		params.Set("width", strconv.Itoa(width))
+	}
+	if height > 0 {
+		params.Set("height", strconv.Itoa(height))
+	}
+	if fallbackToDefault {
+		params.Set("fallbackToDefault", "true")
+	}
+	if len(params) > 0 {
+		u = fmt.Sprintf("%s?%s", u, params.Encode())
+	}
+
+	return s.download(ctx, u, w)
+}
+
+func (s *AttachmentsService) download(ctx context.Context, u string, w io.Writer) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "*/*")
+
+	return s.client.Do(req, w)
+}
+
 // AddToIssue adds attachments to an issue.
 func (s *AttachmentsService) AddToIssue(ctx context.Context, issueIDOrKey string, files map[string]io.Reader) ([]*Attachment, *Response, error) {
 	u := fmt.Sprintf("/rest/api/3/issue/%s/attachments", issueIDOrKey)
@@ -171,7 +218,7 @@ func (s *AttachmentsService) AddToIssue(ctx context.Context, issueIDOrKey string
 		return nil, nil, fmt.Errorf("close writer: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.client.baseURL.String()+u, &body)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.client.baseURL.String()+s.client.apiPath(u), &body)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -181,8 +228,8 @@ func (s *AttachmentsService) AddToIssue(ctx context.Context, issueIDOrKey string
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.client.UserAgent)
 
-	if s.client.auth != nil {
-		s.client.auth.Apply(req)
+	if err := s.client.authenticate(req); err != nil {
+		return nil, nil, err
 	}
 
 	var attachments []*Attachment
@@ -256,7 +303,7 @@ func (s *AttachmentsService) GetThumbnail(ctx context.Context, attachmentID stri
 		return nil, nil, err
 	}
 
-	resp, err := s.client.client.Do(req)
+	resp, err := s.client.send(req)
 	if err != nil {
 		return nil, nil, err
 	}
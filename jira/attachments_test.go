@@ -0,0 +1,135 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAttachmentsService_DownloadContent(t *testing.T) {
+	content := strings.Repeat("attachment bytes ", 4096)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/attachment/content/10000":
+			http.Redirect(w, r, "/media/10000", http.StatusSeeOther)
+		case "/media/10000":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Write([]byte(content))
+		default:
+			t.Errorf("unexpected path %v", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	var buf bytes.Buffer
+	resp, err := client.Attachments.DownloadContent(context.Background(), "10000", &buf)
+	if err != nil {
+		t.Fatalf("DownloadContent() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	if buf.String() != content {
+		t.Errorf("downloaded %d bytes, want %d", buf.Len(), len(content))
+	}
+}
+
+func TestAttachmentsService_DownloadContent_Slow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("first half, "))
+		w.(http.Flusher).Flush()
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte("second half"))
+	}))
+	defer server.Close()
+
+	// The copy outlasts the client's timeout but not the context.
+	client, _ := NewClient(server.URL, WithTimeout(20*time.Millisecond))
+	var buf bytes.Buffer
+	if _, err := client.Attachments.DownloadContent(context.Background(), "10000", &buf); err != nil {
+		t.Fatalf("DownloadContent() error = %v", err)
+	}
+	if got, want := buf.String(), "first half, second half"; got != want {
+		t.Errorf("downloaded %q, want %q", got, want)
+	}
+}
+
+func TestAttachmentsService_DownloadContent_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errorMessages":["The attachment does not exist"]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	var buf bytes.Buffer
+	_, err := client.Attachments.DownloadContent(context.Background(), "404", &buf)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("DownloadContent() error = %v, want *ErrorResponse with 404", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("wrote %q on error, want nothing", buf.String())
+	}
+}
+
+func TestAttachmentsService_DownloadThumbnail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/attachment/thumbnail/10000" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/attachment/thumbnail/10000")
+		}
+		if got := r.URL.RawQuery; got != "fallbackToDefault=true&width=64" {
+			t.Errorf("query = %v, want %v", got, "fallbackToDefault=true&width=64")
+		}
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte("png"))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	var buf bytes.Buffer
+	if _, err := client.Attachments.DownloadThumbnail(context.Background(), "10000", 64, 0, true, &buf); err != nil {
+		t.Fatalf("DownloadThumbnail() error = %v", err)
+	}
+	if buf.String() != "png" {
+		t.Errorf("thumbnail = %q, want %q", buf.String(), "png")
+	}
+}
+
+func TestAttachmentsService_Download_Retries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("file contents"))
+	}))
+	defer server.Close()
+
+	var metrics []Metric
+	client, _ := NewClient(server.URL, WithRetry(1, time.Millisecond), WithObserver(func(m Metric) {
+		metrics = append(metrics, m)
+	}))
+	body, _, err := client.Attachments.Download(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	defer body.Close()
+	data, _ := io.ReadAll(body)
+	if string(data) != "file contents" {
+		t.Errorf("body = %q, want %q", data, "file contents")
+	}
+	if attempts != 2 || len(metrics) != 2 {
+		t.Errorf("attempts = %v, metrics = %v, want 2 of each", attempts, len(metrics))
+	}
+}
@@ -53,13 +53,15 @@ type AuditRecordsResult struct {
 	Records []*AuditRecord `json:"records,omitempty"`
 }
 
-// ListOptions specifies options for listing audit records.
+// AuditRecordsListOptions specifies options for listing audit records.
+// From and To bound the creation time of the records returned; each is a
+// date such as 2024-03-01 or an ISO 8601 date-time.
 type AuditRecordsListOptions struct {
 	Offset int    `url:"offset,omitempty"`
 	Limit  int    `url:"limit,omitempty"`
 	Filter string `url:"filter,omitempty"`
-	From   string `url:"from,omitempty"` // Date format: yyyy-MM-dd
-	To     string `url:"to,omitempty"`   // Date format: yyyy-MM-dd
+	From   string `url:"from,omitempty"`
+	To     string `url:"to,omitempty"`
 }
 
 // List returns audit records.
@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAuditRecordsService_List(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/auditing/record" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/auditing/record")
+		}
+		want := "filter=user+created&from=2024-03-01T00%3A00%3A00.000%2B0000&limit=50&offset=100&to=2024-03-31"
+		if r.URL.RawQuery != want {
+			t.Errorf("query = %v, want %v", r.URL.RawQuery, want)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"offset": 100,
+			"limit": 50,
+			"total": 101,
+			"records": [{
+				"id": 1,
+				"summary": "User created",
+				"created": "2024-03-04T10:15:00.000+0000",
+				"category": "user management",
+				"eventSource": "",
+				"authorAccountId": "557058:abc",
+				"objectItem": {"id": "557058:def", "name": "jdoe", "typeName": "USER"},
+				"changedValues": [{"fieldName": "Email", "changedTo": "jdoe@example.com"}],
+				"associatedItems": [{"id": "jira-software-users", "name": "jira-software-users", "typeName": "GROUP"}]
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, _, err := client.AuditRecords.List(context.Background(), &AuditRecordsListOptions{
+		Offset: 100,
+		Limit:  50,
+		Filter: "user created",
+		From:   "2024-03-01T00:00:00.000+0000",
+		To:     "2024-03-31",
+	})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if result.Total != 101 || len(result.Records) != 1 {
+		t.Fatalf("result = %+v, want 1 of 101 records", result)
+	}
+
+	record := result.Records[0]
+	if record.Summary != "User created" || record.Category != "user management" {
+		t.Errorf("Summary, Category = %v, %v", record.Summary, record.Category)
+	}
+	if record.Created != "2024-03-04T10:15:00.000+0000" {
+		t.Errorf("Created = %v", record.Created)
+	}
+	if record.ObjectItem == nil || record.ObjectItem.TypeName != "USER" {
+		t.Errorf("ObjectItem = %+v, want a USER", record.ObjectItem)
+	}
+	if len(record.ChangedValues) != 1 || record.ChangedValues[0].ChangedTo != "jdoe@example.com" {
+		t.Errorf("ChangedValues = %+v", record.ChangedValues)
+	}
+	if len(record.AssociatedItems) != 1 || record.AssociatedItems[0].TypeName != "GROUP" {
+		t.Errorf("AssociatedItems = %+v", record.AssociatedItems)
+	}
+}
@@ -90,22 +90,30 @@ func (s *AvatarsService) DeleteProjectAvatar(ctx context.Context, projectIDOrKey
 	return s.client.Do(req, nil)
 }
 
-// LoadProjectAvatar loads a custom avatar for a project.
+// LoadProjectAvatar loads a custom avatar for a project from PNG, JPEG or
+// GIF data, cropped to the size-pixel square at x, y.
 func (s *AvatarsService) LoadProjectAvatar(ctx context.Context, projectIDOrKey string, x, y, size int, data []byte) (*Avatar, *Response, error) {
 	u := fmt.Sprintf("/rest/api/3/project/%s/avatar2?x=%d&y=%d&size=%d", projectIDOrKey, x, y, size)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.client.baseURL.String()+u, bytes.NewReader(data))
+	return s.load(ctx, u, data)
+}
+
+// load uploads an avatar image to an avatar2 endpoint. Jira takes the raw
+// image rather than JSON, so this bypasses NewRequest, and rejects the upload
+// without the X-Atlassian-Token header.
+func (s *AvatarsService) load(ctx context.Context, u string, data []byte) (*Avatar, *Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.client.baseURL.String()+s.client.apiPath(u), bytes.NewReader(data))
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req.Header.Set("Content-Type", "image/png")
+	req.Header.Set("Content-Type", http.DetectContentType(data))
 	req.Header.Set("X-Atlassian-Token", "no-check")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", s.client.UserAgent)
 
-	if s.client.auth != nil {
-		s.client.auth.Apply(req)
+	if err := s.client.authenticate(req); err != nil {
+		return nil, nil, err
 	}
 
 	avatar := new(Avatar)
@@ -135,31 +143,12 @@ func (s *AvatarsService) GetIssueTypeAvatars(ctx context.Context, issueTypeID st
 	return result, resp, nil
 }
 
-// LoadIssueTypeAvatar loads a custom avatar for an issue type.
+// LoadIssueTypeAvatar loads a custom avatar for an issue type from PNG, JPEG
+// or GIF data, cropped to the size-pixel square at x, y.
 func (s *AvatarsService) LoadIssueTypeAvatar(ctx context.Context, issueTypeID string, x, y, size int, data []byte) (*Avatar, *Response, error) {
 	u := fmt.Sprintf("/rest/api/3/issuetype/%s/avatar2?x=%d&y=%d&size=%d", issueTypeID, x, y, size)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.client.baseURL.String()+u, bytes.NewReader(data))
-	if err != nil {
-		return nil, nil, err
-	}
-
-	req.Header.Set("Content-Type", "image/png")
-	req.Header.Set("X-Atlassian-Token", "no-check")
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", s.client.UserAgent)
-
-	if s.client.auth != nil {
-		s.client.auth.Apply(req)
-	}
-
-	avatar := new(Avatar)
-	resp, err := s.client.Do(req, avatar)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	return avatar, resp, nil
+	return s.load(ctx, u, data)
 }
 
 // GetUniversalAvatar returns a universal avatar image.
@@ -185,7 +174,7 @@ func (s *AvatarsService) GetUniversalAvatar(ctx context.Context, avatarType stri
 		return nil, nil, err
 	}
 
-	resp, err := s.client.client.Do(req)
+	resp, err := s.client.send(req)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,122 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAvatarsService_GetProjectAvatars(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/project/TEST/avatars" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/project/TEST/avatars")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"system": [{"id": "10200", "isSystemAvatar": true, "isSelected": false, "isDeletable": false,
+				"urls": {"16x16": "https://example.com/16", "48x48": "https://example.com/48"}}],
+			"custom": [{"id": "10600", "owner": "10000", "isSystemAvatar": false, "isSelected": true, "isDeletable": true}]
+		}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	avatars, _, err := client.Avatars.GetProjectAvatars(context.Background(), "TEST")
+	if err != nil {
+		t.Fatalf("GetProjectAvatars() error = %v", err)
+	}
+	if len(avatars.System) != 1 || avatars.System[0].URLs["48x48"] != "https://example.com/48" {
+		t.Errorf("System = %+v", avatars.System)
+	}
+	if len(avatars.Custom) != 1 || !avatars.Custom[0].IsSelected || avatars.Custom[0].Owner != "10000" {
+		t.Errorf("Custom = %+v", avatars.Custom)
+	}
+}
+
+func TestAvatarsService_GetSystemAvatars(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/avatar/issuetype/system" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/avatar/issuetype/system")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"system":[{"id":"10300","isSystemAvatar":true},{"id":"10301","isSystemAvatar":true}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	avatars, _, err := client.Avatars.GetSystemAvatars(context.Background(), "issuetype")
+	if err != nil {
+		t.Fatalf("GetSystemAvatars() error = %v", err)
+	}
+	if len(avatars.System) != 2 || avatars.System[1].ID != "10301" {
+		t.Errorf("System = %+v", avatars.System)
+	}
+}
+
+func TestAvatarsService_Load(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	tests := []struct {
+		name     string
+		wantPath string
+		call     func(*Client) (*Avatar, *Response, error)
+	}{
+		{
+			name:     "LoadProjectAvatar",
+			wantPath: "/rest/api/3/project/TEST/avatar2",
+			call: func(c *Client) (*Avatar, *Response, error) {
+				return c.Avatars.LoadProjectAvatar(context.Background(), "TEST", 0, 0, 48, png)
+			},
+		},
+		{
+			name:     "LoadIssueTypeAvatar",
+			wantPath: "/rest/api/3/issuetype/10001/avatar2",
+			call: func(c *Client) (*Avatar, *Response, error) {
+				return c.Avatars.LoadIssueTypeAvatar(context.Background(), "10001", 0, 0, 48, png)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Method = %v, want %v", r.Method, http.MethodPost)
+				}
+				if r.URL.Path != tt.wantPath {
+					t.Errorf("URL path = %v, want %v", r.URL.Path, tt.wantPath)
+				}
+				if got := r.URL.RawQuery; got != "x=0&y=0&size=48" {
+					t.Errorf("query = %v, want %v", got, "x=0&y=0&size=48")
+				}
+				if got := r.Header.Get("Content-Type"); got != "image/png" {
+					t.Errorf("Content-Type = %v, want %v", got, "image/png")
+				}
+				if got := r.Header.Get("X-Atlassian-Token"); got != "no-check" {
+					t.Errorf("X-Atlassian-Token = %v, want %v", got, "no-check")
+				}
+				body, _ := io.ReadAll(r.Body)
+				if !bytes.Equal(body, png) {
+					t.Errorf("body = %q, want %q", body, png)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"id":"10700","isDeletable":true}`))
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			avatar, _, err := tt.call(client)
+			if err != nil {
+				t.Fatalf("%s() error = %v", tt.name, err)
+			}
+			if avatar.ID != "10700" {
+				t.Errorf("ID = %v, want %v", avatar.ID, "10700")
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package jira
+
+// This file holds context-free wrappers for the most common calls, intended
+// for small scripts and CLIs. Each wrapper uses Client.DefaultContext; code
+// that needs cancellation or deadlines per call should use the context-taking
+// method instead.
+
+// GetBg is like Get but uses the client's default context.
+func (s *IssuesService) GetBg(issueIDOrKey string, opts *IssueGetOptions) (*Issue, *Response, error) {
+	return s.Get(s.client.DefaultContext(), issueIDOrKey, opts)
+}
+
+// CreateBg is like Create but uses the client's default context.
+func (s *IssuesService) CreateBg(issue *IssueCreateRequest) (*IssueCreateResponse, *Response, error) {
+	return s.Create(s.client.DefaultContext(), issue)
+}
+
+// UpdateBg is like Update but uses the client's default context.
+func (s *IssuesService) UpdateBg(issueIDOrKey string, issue *IssueUpdateRequest, opts *IssueUpdateOptions) (*Response, error) {
+	return s.Update(s.client.DefaultContext(), issueIDOrKey, issue, opts)
+}
+
+// DeleteBg is like Delete but uses the client's default context.
+func (s *IssuesService) DeleteBg(issueIDOrKey string, deleteSubtasks bool) (*Response, error) {
+	return s.Delete(s.client.DefaultContext(), issueIDOrKey, deleteSubtasks)
+}
+
+// AssignBg is like Assign but uses the client's default context.
+func (s *IssuesService) AssignBg(issueIDOrKey, accountID string) (*Response, error) {
+	return s.Assign(s.client.DefaultContext(), issueIDOrKey, accountID)
+}
+
+// DoBg is like Do but uses the client's default context.
+func (s *SearchService) DoBg(jql string, opts *SearchOptions) (*SearchResult, *Response, error) {
+	return s.Do(s.client.DefaultContext(), jql, opts)
+}
+
+// AddBg is like Add but uses the client's default context.
+func (s *CommentsService) AddBg(issueIDOrKey string, comment *CommentCreateRequest, expand []string) (*Comment, *Response, error) {
+	return s.Add(s.client.DefaultContext(), issueIDOrKey, comment, expand)
+}
+
+// GetBg is like Get but uses the client's default context.
+func (s *ProjectsService) GetBg(projectIDOrKey string, opts *GetProjectOptions) (*Project, *Response, error) {
+	return s.Get(s.client.DefaultContext(), projectIDOrKey, opts)
+}
@@ -25,17 +25,34 @@
 //
 //	// List projects
 //	projects, _, err := client.Projects.List(ctx, nil)
+//
+// # Admin Overrides
+//
+// Several methods accept override flags that Jira only honours for Connect and
+// Forge apps acting as a user with the Administer Jira global permission; other
+// callers get a 403. The client sends each flag only when it is true, so the
+// zero value is always safe:
+//
+//   - overrideScreenSecurity: IssueUpdateOptions, EditMetaOptions
+//   - overrideEditableFlag: IssueUpdateOptions, EditMetaOptions,
+//     CommentsService.Update, WorklogsService.Add, Update and Delete
+//   - overrideSharePermissions: FilterGetOptions, SearchFiltersOptions,
+//     FiltersService.Create and Update
 package jira
 
 import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -44,13 +61,34 @@ const (
 	// Users should replace this with their own instance URL.
 	DefaultBaseURL = "https://your-domain.atlassian.net"
 
-	// APIVersion is the Jira REST API version this client targets.
+	// APIVersion is the Jira REST API version the client targets unless
+	// WithAPIVersion sets another.
 	APIVersion = "3"
 
 	// UserAgent is the default user agent string.
 	UserAgent = "go-jira/1.0"
+
+	// DefaultMaxRequestBodySize is the default limit on the size of a JSON
+	// request body, matching the 10 MB Jira accepts before replying 413.
+	DefaultMaxRequestBodySize = 10 << 20
+
+	// DefaultTimeout bounds requests whose context has no deadline, so a
+	// call made with context.Background() can't hang on an unresponsive
+	// server.
+	DefaultTimeout = 30 * time.Second
 )
 
+// ErrRequestTooLarge is returned by NewRequest when the encoded request body
+// exceeds the client's limit. Bulk calls that fail with it should be split
+// into smaller chunks.
+var ErrRequestTooLarge = errors.New("jira: request body too large")
+
+// ErrNotModified is returned by Do when the server answers a conditional
+// request, such as one with an If-None-Match or If-Modified-Since header,
+// with 304 Not Modified.
+// The caller's cached copy is still current.
+var ErrNotModified = errors.New("jira: not modified")
+
 // Client manages communication with the Jira API.
 type Client struct {
 	// HTTP client used to communicate with the API.
@@ -65,40 +103,87 @@ type Client struct {
 	// Authentication method
 	auth Authenticator
 
+	// Context used by the ...Bg convenience methods.
+	defaultCtx context.Context
+
+	// Largest request body NewRequest will build; zero or less means no limit.
+	maxBodySize int64
+
+	// Slots shared by the concurrent batch helpers; nil means no limit.
+	batchSlots chan struct{}
+
+	// REST API version used in request paths, such as "3" or "2".
+	apiVersion string
+
+	// How methods taking a user identify them to Jira.
+	userIdentifier UserIdentifierMode
+
+	// Working day and week lengths used by the client's duration helpers.
+	durationFormat DurationFormat
+
+	// Callback given each response and its body; nil disables it.
+	responseLogger func(req *http.Request, resp *http.Response, body []byte)
+
+	// Retries for rate-limited and unavailable responses; zero disables them.
+	maxRetries     int
+	retryBaseDelay time.Duration
+
+	// Paces every request sent, retries included; nil disables it.
+	rateLimiter RateLimiter
+
+	// Called after every attempt to send a request; nil disables it.
+	observer func(Metric)
+
+	// Deadline Do gives requests whose context has none; zero means none.
+	timeout time.Duration
+
+	// Server info fetched by IsCloud, kept for the client's lifetime.
+	serverInfoMu sync.Mutex
+	serverInfo   *ServerInfo
+
+	// Field list fetched by FieldsService.Map and ResolveID; nil until then
+	// and after a field is changed, which also increments fieldsGen.
+	fieldsMu  sync.Mutex
+	fields    []*Field
+	fieldsGen uint64
+
 	// Services for different API groups
-	Issues           *IssuesService
-	Search           *SearchService
-	Projects         *ProjectsService
-	Users            *UsersService
-	Groups           *GroupsService
-	Filters          *FiltersService
-	Dashboards       *DashboardsService
-	IssueTypes       *IssueTypesService
-	Priorities       *PrioritiesService
-	Resolutions      *ResolutionsService
-	Statuses         *StatusesService
-	Components       *ComponentsService
-	Versions         *VersionsService
-	IssueLinks       *IssueLinksService
-	IssueLinkTypes   *IssueLinkTypesService
-	Attachments      *AttachmentsService
-	Comments         *CommentsService
-	Worklogs         *WorklogsService
-	Watchers         *WatchersService
-	Votes            *VotesService
-	Fields           *FieldsService
-	Screens          *ScreensService
-	Workflows        *WorkflowsService
-	WorkflowSchemes  *WorkflowSchemesService
-	Permissions      *PermissionsService
-	ProjectRoles     *ProjectRolesService
-	Labels           *LabelsService
-	ServerInfo       *ServerInfoService
-	Myself           *MyselfService
-	ApplicationRoles *ApplicationRolesService
-	AuditRecords     *AuditRecordsService
-	Avatars          *AvatarsService
-	JQL              *JQLService
+	Issues              *IssuesService
+	Search              *SearchService
+	Projects            *ProjectsService
+	Users               *UsersService
+	Groups              *GroupsService
+	Filters             *FiltersService
+	Dashboards          *DashboardsService
+	IssueTypes          *IssueTypesService
+	Priorities          *PrioritiesService
+	Resolutions         *ResolutionsService
+	Statuses            *StatusesService
+	Components          *ComponentsService
+	Versions            *VersionsService
+	IssueLinks          *IssueLinksService
+	IssueLinkTypes      *IssueLinkTypesService
+	Attachments         *AttachmentsService
+	Comments            *CommentsService
+	Worklogs            *WorklogsService
+	Watchers            *WatchersService
+	Votes               *VotesService
+	Fields              *FieldsService
+	FieldConfigurations *FieldConfigurationsService
+	Screens             *ScreensService
+	Workflows           *WorkflowsService
+	WorkflowSchemes     *WorkflowSchemesService
+	Permissions         *PermissionsService
+	ProjectRoles        *ProjectRolesService
+	Labels              *LabelsService
+	ServerInfo          *ServerInfoService
+	Myself              *MyselfService
+	ApplicationRoles    *ApplicationRolesService
+	AuditRecords        *AuditRecordsService
+	Avatars             *AvatarsService
+	JQL                 *JQLService
+	Tasks               *TasksService
+	Webhooks            *WebhooksService
 }
 
 // Authenticator is the interface for authentication methods.
@@ -128,6 +213,76 @@ func (a *BearerAuth) Apply(req *http.Request) {
 	req.Header.Set("Authorization", "Bearer "+a.Token)
 }
 
+// Token is an OAuth 2.0 access token.
+type Token struct {
+	AccessToken string
+
+	// Expiry is when the token stops being valid. The zero value means it
+	// never expires.
+	Expiry time.Time
+}
+
+// TokenSource supplies OAuth 2.0 access tokens, for example by running the
+// refresh token flow against Atlassian's token endpoint.
+type TokenSource interface {
+	Token() (*Token, error)
+}
+
+// TokenSourceFunc adapts a function to a TokenSource. It lets a
+// golang.org/x/oauth2 TokenSource be used without this package depending on
+// that module:
+//
+//	jira.TokenSourceFunc(func() (*jira.Token, error) {
+//		t, err := ts.Token()
+//		if err != nil {
+//			return nil, err
+//		}
+//		return &jira.Token{AccessToken: t.AccessToken, Expiry: t.Expiry}, nil
+//	})
+type TokenSourceFunc func() (*Token, error)
+
+// Token calls f.
+func (f TokenSourceFunc) Token() (*Token, error) { return f() }
+
+// tokenExpiryDelta is how long before its expiry a token is replaced, so
+// that it doesn't expire while a request is in flight.
+const tokenExpiryDelta = 10 * time.Second
+
+// OAuthTokenAuth implements bearer authentication with tokens from a
+// TokenSource. It reuses a token until it is about to expire and then asks
+// the source for a new one.
+type OAuthTokenAuth struct {
+	Source TokenSource
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// Apply adds the current access token to the request. A token that can't be
+// fetched leaves the request unauthenticated; the client's own requests
+// report that error instead of sending the request.
+func (a *OAuthTokenAuth) Apply(req *http.Request) {
+	_ = a.applyToken(req)
+}
+
+func (a *OAuthTokenAuth) applyToken(req *http.Request) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token == nil || (!a.token.Expiry.IsZero() && time.Now().Add(tokenExpiryDelta).After(a.token.Expiry)) {
+		token, err := a.Source.Token()
+		if err != nil {
+			return fmt.Errorf("fetch OAuth token: %w", err)
+		}
+		if token == nil || token.AccessToken == "" {
+			return errors.New("fetch OAuth token: token source returned no access token")
+		}
+		a.token = token
+	}
+	req.Header.Set("Authorization", "Bearer "+a.token.AccessToken)
+	return nil
+}
+
 // ClientOption configures the Client.
 type ClientOption func(*Client)
 
@@ -138,6 +293,22 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
+// WithTimeout makes Do give up on a request after d, retries included, if its
+// context has no deadline of its own. A context deadline always takes
+// precedence, so one client can serve both a long export under a 5 minute
+// context and a health check under a 5 second one. The default is
+// DefaultTimeout; a d of zero or less leaves requests bounded only by their
+// context. Methods that return a response body for the caller to read, such
+// as AttachmentsService.Download, are not affected, as the body outlives the
+// call, and neither are requests that stream to an io.Writer, such as
+// AttachmentsService.DownloadContent, as a large file can take longer than
+// d to copy. Bound them with the context.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.timeout = d
+	}
+}
+
 // WithBasicAuth sets basic authentication with email and API token.
 func WithBasicAuth(email, apiToken string) ClientOption {
 	return func(c *Client) {
@@ -152,6 +323,16 @@ func WithBearerToken(token string) ClientOption {
 	}
 }
 
+// WithOAuthTokenSource sets OAuth 2.0 (3LO) bearer authentication with
+// tokens from ts, which is asked for a fresh token whenever the current one
+// is about to expire. Use it instead of WithBearerToken when access tokens
+// rotate.
+func WithOAuthTokenSource(ts TokenSource) ClientOption {
+	return func(c *Client) {
+		c.auth = &OAuthTokenAuth{Source: ts}
+	}
+}
+
 // WithUserAgent sets a custom user agent string.
 func WithUserAgent(userAgent string) ClientOption {
 	return func(c *Client) {
@@ -159,23 +340,260 @@ func WithUserAgent(userAgent string) ClientOption {
 	}
 }
 
-// NewClient returns a new Jira API client.
-func NewClient(baseURL string, opts ...ClientOption) (*Client, error) {
-	if baseURL == "" {
-		baseURL = DefaultBaseURL
+// WithDefaultContext sets the context used by the ...Bg convenience methods,
+// such as IssuesService.GetBg. It has no effect on methods that take a context.
+func WithDefaultContext(ctx context.Context) ClientOption {
+	return func(c *Client) {
+		c.defaultCtx = ctx
+	}
+}
+
+// WithMaxRequestBodySize sets the largest JSON request body the client will
+// send, in bytes. Larger requests fail with ErrRequestTooLarge before reaching
+// Jira. A size of zero or less disables the check. The default is
+// DefaultMaxRequestBodySize.
+func WithMaxRequestBodySize(size int64) ClientOption {
+	return func(c *Client) {
+		c.maxBodySize = size
+	}
+}
+
+// WithMaxConcurrency limits the number of requests the client's batch
+// helpers, such as IssuesService.AssignByJQL and UsersService.AddToGroups,
+// have in flight at once, across all calls sharing the client. This keeps
+// helpers running side by side from adding up to more load than Jira's rate
+// limits allow. Each helper's own concurrency argument still applies. A
+// value of zero or less means no shared limit, which is the default.
+func WithMaxConcurrency(n int) ClientOption {
+	return func(c *Client) {
+		if n > 0 {
+			c.batchSlots = make(chan struct{}, n)
+		} else {
+			c.batchSlots = nil
+		}
+	}
+}
+
+// WithAPIVersion sets the Jira REST API version used in request paths, for
+// example "2" to send /rest/api/2/... requests to a Jira Server or Data
+// Center instance. The default is APIVersion. Types in this package follow
+// version 3, so fields whose format differs between versions, such as rich
+// text, may need handling by the caller.
+//
+// Service methods build their paths for version 3; the client rewrites the
+// /rest/api/3 prefix of every path it sends, including the multipart and
+// binary requests of AttachmentsService and AvatarsService, so the version
+// applies to all of them. Paths outside /rest/api/3, such as /rest/agile/1.0,
+// are sent unchanged.
+func WithAPIVersion(version string) ClientOption {
+	return func(c *Client) {
+		if version != "" {
+			c.apiVersion = strings.Trim(version, "/")
+		}
+	}
+}
+
+// UserIdentifierMode selects how methods that take a user identify them
+// to Jira.
+type UserIdentifierMode string
+
+// User identifier modes for WithUserIdentifierMode.
+const (
+	// UserIdentifierAccountID identifies users by account ID, as Jira Cloud
+	// requires. It is the default.
+	UserIdentifierAccountID UserIdentifierMode = "accountId"
+
+	// UserIdentifierUsername identifies users by username, as Jira Server
+	// and Data Center expect.
+	UserIdentifierUsername UserIdentifierMode = "username"
+)
+
+// WithUserIdentifierMode sets how UsersService.Get, UsersService.Delete and
+// IssuesService.Assign identify users. With UserIdentifierUsername their
+// accountID argument is sent as the username instead, for Jira Server and
+// Data Center. The default is UserIdentifierAccountID.
+func WithUserIdentifierMode(mode UserIdentifierMode) ClientOption {
+	return func(c *Client) {
+		c.userIdentifier = mode
+	}
+}
+
+// WithTimeTrackingConfig sets the working hours per day and days per week
+// that Client.ParseJiraDuration and Client.FormatJiraDuration count in "1d"
+// and "1w". Use the values from the instance's time tracking settings. The
+// default is 8 hours per day and 5 days per week; a value of zero or less
+// keeps the default.
+func WithTimeTrackingConfig(hoursPerDay, daysPerWeek float64) ClientOption {
+	return func(c *Client) {
+		c.durationFormat = DurationFormat{HoursPerDay: hoursPerDay, DaysPerWeek: daysPerWeek}
 	}
+}
+
+// userQueryKey returns the query parameter that identifies a user.
+func (c *Client) userQueryKey() string {
+	if c.userIdentifier == UserIdentifierUsername {
+		return "username"
+	}
+	return "accountId"
+}
+
+// userID returns the identifier of u that userQueryKey and userBodyKey
+// refer to: its username or its account ID.
+func (c *Client) userID(u *User) string {
+	if c.userIdentifier == UserIdentifierUsername {
+		return u.Name
+	}
+	return u.AccountID
+}
+
+// userBodyKey returns the request body field that identifies a user.
+func (c *Client) userBodyKey() string {
+	if c.userIdentifier == UserIdentifierUsername {
+		return "name"
+	}
+	return "accountId"
+}
+
+// WithResponseLogger calls fn with every response Do receives and its raw
+// body, for debugging. The body is buffered so that it can still be decoded
+// afterwards. Successful responses streamed to an io.Writer, such as
+// attachment downloads, are not buffered and fn gets a nil body for them;
+// their error responses are captured as usual. fn must not close or read
+// resp.Body. Responses are not logged by default.
+func WithResponseLogger(fn func(req *http.Request, resp *http.Response, body []byte)) ClientOption {
+	return func(c *Client) {
+		c.responseLogger = fn
+	}
+}
+
+// RateLimiter paces outgoing requests. Wait blocks until a request may be
+// sent, or returns an error if ctx is done first. A *rate.Limiter from
+// golang.org/x/time/rate satisfies it.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
 
-	parsedURL, err := url.Parse(baseURL)
+// WithRateLimiter makes Do wait on limiter before sending each request,
+// including each retry, so bulk jobs stay under Jira's rate limits instead
+// of relying on WithRetry to recover from 429 responses:
+//
+//	jira.WithRateLimiter(rate.NewLimiter(rate.Every(100*time.Millisecond), 1))
+//
+// If the request's context is done while waiting, Do returns its error
+// without sending. Requests are not rate limited by default.
+func WithRateLimiter(limiter RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
+}
+
+// Metric describes one attempt to send a request, as reported to the
+// observer set by WithObserver.
+type Metric struct {
+	Method string
+
+	// Path is the request path with numeric IDs replaced by "{id}" and issue
+	// keys by "{key}", such as "/rest/api/3/issue/{key}/comment/{id}", so
+	// that it can label per-endpoint metrics.
+	Path string
+
+	// StatusCode is the response status, or zero if no response arrived.
+	StatusCode int
+
+	// Duration is the time from sending the request to receiving the
+	// response headers. It excludes WithRateLimiter and WithRetry waits.
+	Duration time.Duration
+
+	// Retry is zero for the first attempt and counts up for each retry.
+	Retry int
+
+	// Err is the transport error if no response arrived.
+	Err error
+}
+
+// WithObserver calls fn after every attempt Do makes to send a request,
+// retries included, for example to record per-endpoint latency and status
+// counts. fn is called on the goroutine that called Do and should return
+// quickly.
+func WithObserver(fn func(Metric)) ClientOption {
+	return func(c *Client) {
+		c.observer = fn
+	}
+}
+
+// templatePath replaces the numeric IDs and issue keys in an API path with
+// placeholders. The version after "api", as in /rest/api/3, is kept.
+func templatePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		switch {
+		case i > 0 && segments[i-1] == "api":
+		case isDigits(seg):
+			segments[i] = "{id}"
+		case isIssueKey(seg):
+			segments[i] = "{key}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isIssueKey reports whether s looks like an issue key such as "PROJ-123".
+func isIssueKey(s string) bool {
+	project, number, ok := strings.Cut(s, "-")
+	if !ok || project == "" || project[0] < 'A' || project[0] > 'Z' || !isDigits(number) {
+		return false
+	}
+	for _, r := range project {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// isDigits reports whether s is a non-empty run of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// WithRetry makes Do retry requests that fail with 429 Too Many Requests or
+// 503 Service Unavailable, and GET, HEAD, OPTIONS, PUT and DELETE requests
+// that fail with 502 Bad Gateway or 504 Gateway Timeout, up to maxRetries
+// times. A POST or PATCH that got a 502 or 504 may have been applied, so it
+// is not sent again. It waits for the Retry-After header when the response has
+// one, and otherwise for baseDelay doubled on each attempt. Waiting stops
+// early if the request's context is done.
+func WithRetry(maxRetries int, baseDelay time.Duration) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.retryBaseDelay = baseDelay
+	}
+}
+
+// NewClient returns a new Jira API client.
+func NewClient(baseURL string, opts ...ClientOption) (*Client, error) {
+	parsedURL, err := normalizeBaseURL(baseURL)
 	if err != nil {
-		return nil, fmt.Errorf("invalid base URL: %w", err)
+		return nil, err
 	}
 
 	c := &Client{
-		client: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-		baseURL:   parsedURL,
-		UserAgent: UserAgent,
+		client:         &http.Client{},
+		baseURL:        parsedURL,
+		UserAgent:      UserAgent,
+		maxBodySize:    DefaultMaxRequestBodySize,
+		apiVersion:     APIVersion,
+		userIdentifier: UserIdentifierAccountID,
+		durationFormat: DefaultDurationFormat,
+		timeout:        DefaultTimeout,
 	}
 
 	for _, opt := range opts {
@@ -204,6 +622,7 @@ func NewClient(baseURL string, opts ...ClientOption) (*Client, error) {
 	c.Watchers = &WatchersService{client: c}
 	c.Votes = &VotesService{client: c}
 	c.Fields = &FieldsService{client: c}
+	c.FieldConfigurations = &FieldConfigurationsService{client: c}
 	c.Screens = &ScreensService{client: c}
 	c.Workflows = &WorkflowsService{client: c}
 	c.WorkflowSchemes = &WorkflowSchemesService{client: c}
@@ -216,23 +635,110 @@ func NewClient(baseURL string, opts ...ClientOption) (*Client, error) {
 	c.AuditRecords = &AuditRecordsService{client: c}
 	c.Avatars = &AvatarsService{client: c}
 	c.JQL = &JQLService{client: c}
+	c.Tasks = &TasksService{client: c}
+	c.Webhooks = &WebhooksService{client: c}
 
 	return c, nil
 }
 
+// normalizeBaseURL parses a Jira site URL. A missing scheme is assumed to be
+// https, trailing slashes are removed, and any context path (as used by some
+// Server/Data Center installs) is kept so that request paths are appended to it.
+func normalizeBaseURL(baseURL string) (*url.URL, error) {
+	baseURL = strings.TrimSpace(baseURL)
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if !strings.Contains(baseURL, "://") {
+		baseURL = "https://" + baseURL
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("invalid base URL %q: scheme must be http or https", baseURL)
+	}
+	if !isPlausibleHost(u.Hostname()) {
+		return nil, fmt.Errorf("invalid base URL %q: missing or malformed host", baseURL)
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return nil, fmt.Errorf("invalid base URL %q: must not contain credentials, a query or a fragment", baseURL)
+	}
+
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+	return u, nil
+}
+
+// isPlausibleHost reports whether host is a plausible hostname or IP address.
+// Underscores are allowed, as in internal hostnames such as jira_prod, which
+// resolvers accept although DNS hostname rules don't.
+func isPlausibleHost(host string) bool {
+	if host == "" {
+		return false
+	}
+	for _, r := range host {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '.', r == ':', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // Response represents an API response.
 type Response struct {
 	*http.Response
 
-	// For paginated responses
-	StartAt    int
-	MaxResults int
-	Total      int
+	// Paging values from the response body. Do copies them from the
+	// same-named fields of the result it decoded into, so they are left zero
+	// for responses that aren't paginated and for result types that don't
+	// declare them. Endpoints that page by token set NextPageToken instead
+	// of StartAt and Total.
+	StartAt       int
+	MaxResults    int
+	Total         int
+	IsLast        bool
+	NextPageToken string
+
+	// Deprecations holds the Warning, Deprecation and Sunset headers Jira sent
+	// with the response. A non-empty slice means the endpoint is scheduled for
+	// removal and callers should plan a migration.
+	Deprecations []string
+
+	// ETag is the entity tag the server sent, if any. Pass it back as
+	// If-None-Match to make a conditional request.
+	ETag string
+
+	// LastModified is the time in the Last-Modified header, or zero if the
+	// server sent none. Pass it back as If-Modified-Since to make a
+	// conditional request.
+	LastModified time.Time
 }
 
 // newResponse creates a new Response from an http.Response.
 func newResponse(r *http.Response) *Response {
-	return &Response{Response: r}
+	response := &Response{Response: r, ETag: r.Header.Get("ETag")}
+	if lm, err := http.ParseTime(r.Header.Get("Last-Modified")); err == nil {
+		response.LastModified = lm
+	}
+	response.populateDeprecations()
+	return response
+}
+
+// populateDeprecations collects deprecation notices from the response headers.
+func (r *Response) populateDeprecations() {
+	r.Deprecations = append(r.Deprecations, r.Header.Values("Warning")...)
+	for _, v := range r.Header.Values("Deprecation") {
+		r.Deprecations = append(r.Deprecations, "Deprecation: "+v)
+	}
+	for _, v := range r.Header.Values("Sunset") {
+		r.Deprecations = append(r.Deprecations, "Sunset: "+v)
+	}
 }
 
 // ErrorResponse represents an error response from the Jira API.
@@ -268,26 +774,109 @@ func (e *ErrorResponse) Error() string {
 		e.Response.StatusCode)
 }
 
-// NewRequest creates an API request.
-func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
-	// Ensure the URL starts with the API path
+// StatusCode returns the HTTP status of the response, or 0 if there is none.
+func (e *ErrorResponse) StatusCode() int {
+	if e.Response == nil {
+		return 0
+	}
+	return e.Response.StatusCode
+}
+
+// IsNotFound reports whether err is, or wraps, an *ErrorResponse with status
+// 404 Not Found.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsRateLimited reports whether err is, or wraps, an *ErrorResponse with
+// status 429 Too Many Requests.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized reports whether err is, or wraps, an *ErrorResponse with
+// status 401 Unauthorized, meaning the credentials are missing or invalid.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is, or wraps, an *ErrorResponse with status
+// 403 Forbidden, meaning the user lacks permission.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+func hasStatus(err error, status int) bool {
+	var errResp *ErrorResponse
+	return errors.As(err, &errResp) && errResp.StatusCode() == status
+}
+
+// DefaultContext returns the context set with WithDefaultContext, or
+// context.Background if none was set.
+func (c *Client) DefaultContext() context.Context {
+	if c.defaultCtx != nil {
+		return c.defaultCtx
+	}
+	return context.Background()
+}
+
+// acquireBatchSlot blocks until one of the slots set by WithMaxConcurrency is
+// free or ctx is done. Each successful call must be paired with
+// releaseBatchSlot.
+func (c *Client) acquireBatchSlot(ctx context.Context) error {
+	if c.batchSlots == nil {
+		return ctx.Err()
+	}
+	select {
+	case c.batchSlots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// releaseBatchSlot frees a slot taken by acquireBatchSlot.
+func (c *Client) releaseBatchSlot() {
+	if c.batchSlots != nil {
+		<-c.batchSlots
+	}
+}
+
+// apiPath returns urlStr with a leading slash and with its /rest/api/3
+// prefix, which service methods use, changed to the client's API version.
+func (c *Client) apiPath(urlStr string) string {
 	if !strings.HasPrefix(urlStr, "/") {
 		urlStr = "/" + urlStr
 	}
+	const servicePrefix = "/rest/api/" + APIVersion
+	if c.apiVersion != APIVersion && c.apiVersion != "" {
+		if rest, ok := strings.CutPrefix(urlStr, servicePrefix); ok && (rest == "" || rest[0] == '/' || rest[0] == '?') {
+			urlStr = "/rest/api/" + c.apiVersion + rest
+		}
+	}
+	return urlStr
+}
 
-	u, err := c.baseURL.Parse(urlStr)
+// NewRequest creates an API request.
+func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
+	u, err := c.baseURL.Parse(c.baseURL.Path + c.apiPath(urlStr))
 	if err != nil {
 		return nil, err
 	}
 
 	var buf io.ReadWriter
 	if body != nil {
-		buf = new(bytes.Buffer)
-		enc := json.NewEncoder(buf)
+		b := new(bytes.Buffer)
+		enc := json.NewEncoder(b)
 		enc.SetEscapeHTML(false)
 		if err := enc.Encode(body); err != nil {
 			return nil, err
 		}
+		if c.maxBodySize > 0 && int64(b.Len()) > c.maxBodySize {
+			return nil, fmt.Errorf("%w: %s %s body is %d bytes, limit is %d; split it into smaller chunks",
+				ErrRequestTooLarge, method, urlStr, b.Len(), c.maxBodySize)
+		}
+		buf = b
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
@@ -301,41 +890,221 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", c.UserAgent)
 
-	if c.auth != nil {
-		c.auth.Apply(req)
+	if err := c.authenticate(req); err != nil {
+		return nil, err
 	}
 
 	return req, nil
 }
 
-// Do sends an API request and returns the API response.
+// authenticate applies the client's Authenticator to req. Authenticators
+// that can fail, such as OAuthTokenAuth, report their error here.
+func (c *Client) authenticate(req *http.Request) error {
+	switch auth := c.auth.(type) {
+	case nil:
+		return nil
+	case interface{ applyToken(*http.Request) error }:
+		return auth.applyToken(req)
+	default:
+		auth.Apply(req)
+		return nil
+	}
+}
+
+// Do sends an API request and returns the API response. A 304 response to
+// a conditional request is reported as ErrNotModified. If v is an io.Writer,
+// the response body is copied to it, and the client's timeout doesn't apply.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.client.Do(req)
+	_, streaming := v.(io.Writer)
+	if _, ok := req.Context().Deadline(); !ok && !streaming && c.timeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.timeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if c.responseLogger != nil {
+		if err := c.logResponse(req, resp, v); err != nil {
+			return newResponse(resp), err
+		}
+	}
+
 	response := newResponse(resp)
 
+	if resp.StatusCode == http.StatusNotModified {
+		return response, ErrNotModified
+	}
+
 	if err := checkResponse(resp); err != nil {
 		return response, err
 	}
 
-	if v != nil && resp.StatusCode != http.StatusNoContent {
-		if w, ok := v.(io.Writer); ok {
-			_, err = io.Copy(w, resp.Body)
-		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
-		}
-		if err != nil && err != io.EOF {
-			return response, err
+	// A 204, or a 200 that declares an empty body, has nothing to decode.
+	if v == nil || resp.StatusCode == http.StatusNoContent || resp.ContentLength == 0 {
+		return response, nil
+	}
+
+	if w, ok := v.(io.Writer); ok {
+		_, err = io.Copy(w, resp.Body)
+		return response, err
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		// Chunked responses don't declare their length, so an empty body
+		// only shows up here.
+		if err == io.EOF {
+			return response, nil
 		}
+		return response, err
 	}
+	response.populatePageValues(v)
 
 	return response, nil
 }
 
+// logResponse passes resp to the response logger, first buffering its body
+// unless it is a successful response to be streamed to an io.Writer.
+func (c *Client) logResponse(req *http.Request, resp *http.Response, v interface{}) error {
+	if _, streaming := v.(io.Writer); streaming && resp.StatusCode < 300 {
+		c.responseLogger(req, resp, nil)
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	c.responseLogger(req, resp, body)
+	return nil
+}
+
+// observe reports one attempt to send req to the observer.
+func (c *Client) observe(req *http.Request, resp *http.Response, err error, d time.Duration, attempt int) {
+	m := Metric{
+		Method:   req.Method,
+		Path:     templatePath(req.URL.Path),
+		Duration: d,
+		Retry:    attempt,
+		Err:      err,
+	}
+	if resp != nil {
+		m.StatusCode = resp.StatusCode
+	}
+	c.observer(m)
+}
+
+// send sends req, retrying as configured by WithRetry. Requests whose body
+// can't be rewound are sent once.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+		start := time.Now()
+		resp, err := c.client.Do(req)
+		if c.observer != nil {
+			c.observe(req, resp, err, time.Since(start), attempt)
+		}
+		if err != nil || attempt >= c.maxRetries || !isRetryable(req.Method, resp.StatusCode) {
+			return resp, err
+		}
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, nil
+		}
+
+		delay := retryDelay(resp, c.retryBaseDelay, attempt)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// isRetryable reports whether a response with the given status to a request
+// with the given method is worth retrying. 429 and 503 mean the request was
+// not processed, so any method is retried; after a 502 or 504 it may have
+// been, so only idempotent methods are.
+func isRetryable(method string, status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		switch method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+			return true
+		}
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before retrying resp: its Retry-After
+// value, given in seconds or as an HTTP date, or else base doubled attempt
+// times.
+func retryDelay(resp *http.Response, base time.Duration, attempt int) time.Duration {
+	if after := resp.Header.Get("Retry-After"); after != "" {
+		if seconds, err := strconv.Atoi(after); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if at, err := http.ParseTime(after); err == nil {
+			return max(time.Until(at), 0)
+		}
+	}
+	return base << attempt
+}
+
+// populatePageValues copies the paging fields of v, the result Do decoded
+// into, if it is a struct or a pointer to one that has any.
+func (r *Response) populatePageValues(v any) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return
+	}
+	if f := rv.FieldByName("StartAt"); f.IsValid() && f.CanInt() {
+		r.StartAt = int(f.Int())
+	}
+	if f := rv.FieldByName("MaxResults"); f.IsValid() && f.CanInt() {
+		r.MaxResults = int(f.Int())
+	}
+	if f := rv.FieldByName("Total"); f.IsValid() && f.CanInt() {
+		r.Total = int(f.Int())
+	}
+	if f := rv.FieldByName("IsLast"); f.IsValid() && f.Kind() == reflect.Bool {
+		r.IsLast = f.Bool()
+	}
+	if f := rv.FieldByName("NextPageToken"); f.IsValid() && f.Kind() == reflect.String {
+		r.NextPageToken = f.String()
+	}
+}
+
 // checkResponse checks the API response for errors.
 func checkResponse(r *http.Response) error {
 	if r.StatusCode >= 200 && r.StatusCode <= 299 {
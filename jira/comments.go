@@ -81,8 +81,9 @@ func (s *CommentsService) Get(ctx context.Context, issueIDOrKey, commentID strin
 
 // CommentCreateRequest represents a request to create a comment.
 type CommentCreateRequest struct {
-	Body       interface{} `json:"body"` // Can be string or ADF document
-	Visibility *Visibility `json:"visibility,omitempty"`
+	Body       interface{}       `json:"body"` // Plain text string or ADF document, such as an *adf.Node
+	Visibility *Visibility       `json:"visibility,omitempty"`
+	Properties []*EntityProperty `json:"properties,omitempty"`
 }
 
 // Visibility represents comment visibility settings.
@@ -92,7 +93,12 @@ type Visibility struct {
 	Identifier string `json:"identifier,omitempty"`
 }
 
-// Add adds a comment to an issue.
+// CommentExpandRenderedBody is the expand value that populates
+// Comment.RenderedBody with the comment rendered as HTML.
+const CommentExpandRenderedBody = "renderedBody"
+
+// Add adds a comment to an issue. A plain text Body is sent as an ADF
+// document, with blank lines separating paragraphs.
 func (s *CommentsService) Add(ctx context.Context, issueIDOrKey string, comment *CommentCreateRequest, expand []string) (*Comment, *Response, error) {
 	u := fmt.Sprintf("/rest/api/3/issue/%s/comment", issueIDOrKey)
 
@@ -100,6 +106,12 @@ func (s *CommentsService) Add(ctx context.Context, issueIDOrKey string, comment
 		u = fmt.Sprintf("%s?expand=%s", u, strings.Join(expand, ","))
 	}
 
+	if comment != nil {
+		c := *comment
+		c.Body = adfBody(c.Body)
+		comment = &c
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, comment)
 	if err != nil {
 		return nil, nil, err
@@ -116,11 +128,13 @@ func (s *CommentsService) Add(ctx context.Context, issueIDOrKey string, comment
 
 // CommentUpdateRequest represents a request to update a comment.
 type CommentUpdateRequest struct {
-	Body       interface{} `json:"body,omitempty"` // Can be string or ADF document
-	Visibility *Visibility `json:"visibility,omitempty"`
+	Body       interface{}       `json:"body,omitempty"` // Plain text string or ADF document, such as an *adf.Node
+	Visibility *Visibility       `json:"visibility,omitempty"`
+	Properties []*EntityProperty `json:"properties,omitempty"`
 }
 
-// Update updates a comment.
+// Update updates a comment. A plain text Body is sent as an ADF document, as
+// in Add.
 func (s *CommentsService) Update(ctx context.Context, issueIDOrKey, commentID string, comment *CommentUpdateRequest, notifyUsers bool, overrideEditableFlag bool, expand []string) (*Comment, *Response, error) {
 	u := fmt.Sprintf("/rest/api/3/issue/%s/comment/%s", issueIDOrKey, commentID)
 
@@ -138,6 +152,12 @@ func (s *CommentsService) Update(ctx context.Context, issueIDOrKey, commentID st
 		u = fmt.Sprintf("%s?%s", u, params.Encode())
 	}
 
+	if comment != nil {
+		c := *comment
+		c.Body = adfBody(c.Body)
+		comment = &c
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodPut, u, comment)
 	if err != nil {
 		return nil, nil, err
@@ -274,3 +294,32 @@ func (s *CommentsService) DeleteProperty(ctx context.Context, issueIDOrKey, comm
 
 	return s.client.Do(req, nil)
 }
+
+// ListByProperty returns the comments on an issue that have a property with
+// the given key, such as a marker an app sets on the comments it posts. The
+// comments are fetched with their properties expanded, so each returned
+// comment's Properties holds the matching value.
+func (s *CommentsService) ListByProperty(ctx context.Context, issueIDOrKey, propertyKey string) ([]*Comment, error) {
+	var matches []*Comment
+	startAt := 0
+	for {
+		result, _, err := s.ListIssueComments(ctx, issueIDOrKey, startAt, 0, "", []string{"properties"})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, comment := range result.Comments {
+			for _, prop := range comment.Properties {
+				if prop.Key == propertyKey {
+					matches = append(matches, comment)
+					break
+				}
+			}
+		}
+
+		startAt += len(result.Comments)
+		if len(result.Comments) == 0 || startAt >= result.Total {
+			return matches, nil
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aaronmaturen/go-jira/jira/adf"
+)
+
+func TestCommentsService_Add(t *testing.T) {
+	doc, _ := adf.NewDocument().Paragraph("Fixed in ").Link("build 42", "https://ci.example.com/42").Build()
+
+	tests := []struct {
+		name     string
+		body     any
+		wantBody string
+	}{
+		{
+			name:     "plain text",
+			body:     "Fixed.\n\nSee the build log.",
+			wantBody: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"Fixed."}]},{"type":"paragraph","content":[{"type":"text","text":"See the build log."}]}]}`,
+		},
+		{
+			name:     "ADF",
+			body:     doc,
+			wantBody: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"Fixed in "},{"type":"text","text":"build 42","marks":[{"type":"link","attrs":{"href":"https://ci.example.com/42"}}]}]}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Method = %v, want %v", r.Method, http.MethodPost)
+				}
+				if r.URL.Path != "/rest/api/3/issue/TEST-1/comment" {
+					t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/issue/TEST-1/comment")
+				}
+				var got struct {
+					Body       json.RawMessage `json:"body"`
+					Visibility *Visibility     `json:"visibility"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Fatalf("decode body: %v", err)
+				}
+				if string(got.Body) != tt.wantBody {
+					t.Errorf("body =\n%s\nwant\n%s", got.Body, tt.wantBody)
+				}
+				if got.Visibility == nil || got.Visibility.Value != "Developers" {
+					t.Errorf("visibility = %+v, want role Developers", got.Visibility)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":"10000"}`))
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			req := &CommentCreateRequest{
+				Body:       tt.body,
+				Visibility: &Visibility{Type: "role", Value: "Developers"},
+			}
+			comment, _, err := client.Comments.Add(context.Background(), "TEST-1", req, nil)
+			if err != nil {
+				t.Fatalf("Add() error = %v", err)
+			}
+			if comment.ID != "10000" {
+				t.Errorf("ID = %v, want %v", comment.ID, "10000")
+			}
+			if req.Body != tt.body {
+				t.Error("Add() modified the caller's request")
+			}
+		})
+	}
+}
+
+func TestCommentsService_Update(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Method = %v, want %v", r.Method, http.MethodPut)
+		}
+		body, _ := io.ReadAll(r.Body)
+		want := `{"body":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"line one"},{"type":"hardBreak"},{"type":"text","text":"line two"}]}]},"properties":[{"key":"bot","value":true}]}`
+		if got := strings.TrimSpace(string(body)); got != want {
+			t.Errorf("body =\n%s\nwant\n%s", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"10000"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	_, _, err := client.Comments.Update(context.Background(), "TEST-1", "10000", &CommentUpdateRequest{
+		Body:       "line one\nline two",
+		Properties: []*EntityProperty{{Key: "bot", Value: true}},
+	}, true, false, nil)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+}
+
+func TestCommentsService_Get_RenderedBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("expand"); got != CommentExpandRenderedBody {
+			t.Errorf("expand = %v, want %v", got, CommentExpandRenderedBody)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"10000","body":{"version":1,"type":"doc","content":[]},"renderedBody":"<p>Fixed.</p>"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	comment, _, err := client.Comments.Get(context.Background(), "TEST-1", "10000", []string{CommentExpandRenderedBody})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if comment.RenderedBody != "<p>Fixed.</p>" {
+		t.Errorf("RenderedBody = %v, want %v", comment.RenderedBody, "<p>Fixed.</p>")
+	}
+}
+
+func TestCommentsService_Add_Properties(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		want := `{"body":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"Build passed"}]}]},"properties":[{"key":"ci.bot","value":{"build":42}}]}`
+		if got := strings.TrimSpace(string(body)); got != want {
+			t.Errorf("body =\n%s\nwant\n%s", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"10000"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	_, _, err := client.Comments.Add(context.Background(), "TEST-1", &CommentCreateRequest{
+		Body:       "Build passed",
+		Properties: []*EntityProperty{{Key: "ci.bot", Value: map[string]int{"build": 42}}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+}
+
+func TestCommentsService_ListByProperty(t *testing.T) {
+	pages := map[string]string{
+		"": `{"startAt":0,"maxResults":2,"total":3,"comments":[` +
+			`{"id":"10000","properties":[{"key":"ci.bot","value":{"build":41}}]},` +
+			`{"id":"10001","properties":[{"key":"other","value":true}]}]}`,
+		"2": `{"startAt":2,"maxResults":2,"total":3,"comments":[` +
+			`{"id":"10002","properties":[{"key":"other","value":true},{"key":"ci.bot","value":{"build":42}}]}]}`,
+	}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if got := r.URL.Query().Get("expand"); got != "properties" {
+			t.Errorf("expand = %v, want %v", got, "properties")
+		}
+		page, ok := pages[r.URL.Query().Get("startAt")]
+		if !ok {
+			t.Errorf("unexpected startAt %q", r.URL.Query().Get("startAt"))
+			page = `{"comments":[]}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	comments, err := client.Comments.ListByProperty(context.Background(), "TEST-1", "ci.bot")
+	if err != nil {
+		t.Fatalf("ListByProperty() error = %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("requests = %v, want %v", requests, 2)
+	}
+	if len(comments) != 2 || comments[0].ID != "10000" || comments[1].ID != "10002" {
+		t.Errorf("comments = %+v, want 10000 and 10002", comments)
+	}
+}
@@ -86,7 +86,8 @@ func (s *ComponentsService) Update(ctx context.Context, componentID string, comp
 	return result, resp, nil
 }
 
-// Delete removes a component.
+// Delete removes a component. If moveIssuesTo is set, the component's
+// issues are moved to that component; otherwise they lose the component.
 func (s *ComponentsService) Delete(ctx context.Context, componentID string, moveIssuesTo string) (*Response, error) {
 	u := fmt.Sprintf("/rest/api/3/component/%s", componentID)
 
@@ -126,6 +127,34 @@ func (s *ComponentsService) GetIssueCount(ctx context.Context, componentID strin
 	return count, resp, nil
 }
 
+// GetReplacementOptions returns the components that the issues of
+// componentID can be moved to when it is deleted: the other components of
+// its project. Pass one of their IDs to Delete as moveIssuesTo.
+func (s *ComponentsService) GetReplacementOptions(ctx context.Context, componentID string) ([]*Component, *Response, error) {
+	component, resp, err := s.Get(ctx, componentID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	project := component.Project
+	if component.ProjectID != 0 {
+		project = strconv.Itoa(component.ProjectID)
+	}
+	all, resp, err := s.ListAllProjectComponents(ctx, project)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	options := make([]*Component, 0, len(all))
+	for _, c := range all {
+		if c.ID != component.ID {
+			options = append(options, c)
+		}
+	}
+
+	return options, resp, nil
+}
+
 // ComponentListResult represents a paginated list of components.
 type ComponentListResult struct {
 	Self       string       `json:"self,omitempty"`
@@ -172,6 +201,42 @@ func (s *ComponentsService) ListProjectComponents(ctx context.Context, projectID
 	return result, resp, nil
 }
 
+// ComponentListOptions specifies optional parameters for ListForProjectWithCounts.
+type ComponentListOptions struct {
+	// OrderBy sorts the components, for example "name" or "-issueCount".
+	OrderBy string
+
+	// Query filters components by name or description.
+	Query string
+}
+
+// ListForProjectWithCounts returns every component of a project with its
+// IssueCount populated. The paginated component endpoint includes the count,
+// so this needs one request per page rather than one per component.
+func (s *ComponentsService) ListForProjectWithCounts(ctx context.Context, projectIDOrKey string, opts *ComponentListOptions) ([]*Component, *Response, error) {
+	if opts == nil {
+		opts = &ComponentListOptions{}
+	}
+
+	var (
+		components []*Component
+		resp       *Response
+	)
+	startAt := 0
+	for {
+		result, r, err := s.ListProjectComponents(ctx, projectIDOrKey, startAt, 0, opts.OrderBy, opts.Query)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		components = append(components, result.Values...)
+		startAt += len(result.Values)
+		if result.IsLast || len(result.Values) == 0 {
+			return components, resp, nil
+		}
+	}
+}
+
 // ListAllProjectComponents returns all components for a project (non-paginated).
 func (s *ComponentsService) ListAllProjectComponents(ctx context.Context, projectIDOrKey string) ([]*Component, *Response, error) {
 	u := fmt.Sprintf("/rest/api/3/project/%s/components", projectIDOrKey)
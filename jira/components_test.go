@@ -0,0 +1,101 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestComponentsService_ListForProjectWithCounts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/project/TEST/component" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/project/TEST/component")
+		}
+		if got := r.URL.Query().Get("orderBy"); got != "-issueCount" {
+			t.Errorf("orderBy = %v, want %v", got, "-issueCount")
+		}
+
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		result := ComponentListResult{StartAt: startAt, Total: 3}
+		if startAt == 0 {
+			result.Values = []*Component{{Name: "API", IssueCount: 12}, {Name: "UI", IssueCount: 4}}
+		} else {
+			result.Values = []*Component{{Name: "Docs", IssueCount: 1}}
+			result.IsLast = true
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	components, _, err := client.Components.ListForProjectWithCounts(context.Background(), "TEST", &ComponentListOptions{OrderBy: "-issueCount"})
+	if err != nil {
+		t.Fatalf("ListForProjectWithCounts() error = %v", err)
+	}
+	if len(components) != 3 || components[0].IssueCount != 12 || components[2].Name != "Docs" {
+		t.Errorf("components = %+v", components)
+	}
+}
+
+func TestComponentsService_Delete(t *testing.T) {
+	tests := []struct {
+		name         string
+		moveIssuesTo string
+		wantQuery    string
+	}{
+		{"reassign", "10001", "moveIssuesTo=10001"},
+		{"no reassign", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Errorf("Method = %v, want %v", r.Method, http.MethodDelete)
+				}
+				if r.URL.Path != "/rest/api/3/component/10000" {
+					t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/component/10000")
+				}
+				if r.URL.RawQuery != tt.wantQuery {
+					t.Errorf("query = %v, want %v", r.URL.RawQuery, tt.wantQuery)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			if _, err := client.Components.Delete(context.Background(), "10000", tt.moveIssuesTo); err != nil {
+				t.Errorf("Delete() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestComponentsService_GetReplacementOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/component/10000":
+			w.Write([]byte(`{"id":"10000","name":"API","project":"TEST","projectId":10100}`))
+		case "/rest/api/3/project/10100/components":
+			w.Write([]byte(`[{"id":"10000","name":"API"},{"id":"10001","name":"UI"},{"id":"10002","name":"Docs"}]`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	options, _, err := client.Components.GetReplacementOptions(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("GetReplacementOptions() error = %v", err)
+	}
+	if len(options) != 2 || options[0].ID != "10001" || options[1].ID != "10002" {
+		t.Errorf("options = %+v, want components 10001 and 10002", options)
+	}
+}
@@ -2,6 +2,7 @@ package jira
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -194,6 +195,13 @@ type DashboardCreateRequest struct {
 
 // Create creates a new dashboard.
 func (s *DashboardsService) Create(ctx context.Context, dashboard *DashboardCreateRequest) (*Dashboard, *Response, error) {
+	if dashboard == nil {
+		return nil, nil, errors.New("dashboard request is nil")
+	}
+	if err := validateSharePermissions(dashboard.SharePermissions, dashboard.EditPermissions); err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodPost, "/rest/api/3/dashboard", dashboard)
 	if err != nil {
 		return nil, nil, err
@@ -218,6 +226,13 @@ type DashboardUpdateRequest struct {
 
 // Update updates a dashboard.
 func (s *DashboardsService) Update(ctx context.Context, dashboardID string, dashboard *DashboardUpdateRequest) (*Dashboard, *Response, error) {
+	if dashboard == nil {
+		return nil, nil, errors.New("dashboard request is nil")
+	}
+	if err := validateSharePermissions(dashboard.SharePermissions, dashboard.EditPermissions); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("/rest/api/3/dashboard/%s", dashboardID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, u, dashboard)
@@ -248,6 +263,13 @@ func (s *DashboardsService) Delete(ctx context.Context, dashboardID string) (*Re
 
 // Copy copies a dashboard.
 func (s *DashboardsService) Copy(ctx context.Context, dashboardID string, dashboard *DashboardCreateRequest) (*Dashboard, *Response, error) {
+	if dashboard == nil {
+		return nil, nil, errors.New("dashboard request is nil")
+	}
+	if err := validateSharePermissions(dashboard.SharePermissions, dashboard.EditPermissions); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("/rest/api/3/dashboard/%s/copy", dashboardID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, dashboard)
@@ -404,21 +426,70 @@ func (s *DashboardsService) ListAvailableGadgets(ctx context.Context) (*Availabl
 	return result, resp, nil
 }
 
-// BulkEdit edits multiple dashboards at once.
+// BulkEdit edits multiple dashboards at once. action is one of "changeOwner",
+// "changePermission", "addPermission" or "removePermission". Build the share
+// permissions with SharePermissionForGroup and the related constructors.
+// A change of owner renames dashboards whose name the new owner already uses;
+// use BulkEditWithOptions to turn that off.
 func (s *DashboardsService) BulkEdit(ctx context.Context, action string, dashboardIDs []string, changeOwnerAccountID string, sharePermissions []*SharePermission, extendAdminPermissions bool) (*BulkEditResult, *Response, error) {
+	return s.BulkEditWithOptions(ctx, action, dashboardIDs, &DashboardBulkEditOptions{
+		ChangeOwnerAccountID:   changeOwnerAccountID,
+		AutofixName:            true,
+		SharePermissions:       sharePermissions,
+		ExtendAdminPermissions: extendAdminPermissions,
+	})
+}
+
+// DashboardBulkEditOptions represents the settings for BulkEditWithOptions.
+type DashboardBulkEditOptions struct {
+	// ChangeOwnerAccountID is the new owner for the "changeOwner" action.
+	ChangeOwnerAccountID string
+
+	// AutofixName renames dashboards whose name the new owner already uses.
+	// Without it those dashboards are reported in EntityErrors.
+	AutofixName bool
+
+	SharePermissions       []*SharePermission
+	ExtendAdminPermissions bool
+}
+
+// BulkEditWithOptions is like BulkEdit but takes its settings from opts,
+// including whether a change of owner may rename clashing dashboards.
+func (s *DashboardsService) BulkEditWithOptions(ctx context.Context, action string, dashboardIDs []string, opts *DashboardBulkEditOptions) (*BulkEditResult, *Response, error) {
+	if opts == nil {
+		opts = &DashboardBulkEditOptions{}
+	}
+	if err := validateSharePermissions(opts.SharePermissions); err != nil {
+		return nil, nil, err
+	}
+
 	u := "/rest/api/3/dashboard/bulk/edit"
 
+	entityIDs := make([]int64, len(dashboardIDs))
+	for i, id := range dashboardIDs {
+		n, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid dashboard ID %q: %w", id, err)
+		}
+		entityIDs[i] = n
+	}
+
 	body := map[string]interface{}{
-		"action":               action,
-		"selectedDashboardIds": dashboardIDs,
+		"action":    action,
+		"entityIds": entityIDs,
 	}
-	if changeOwnerAccountID != "" {
-		body["newOwner"] = map[string]string{"accountId": changeOwnerAccountID}
+	if opts.ChangeOwnerAccountID != "" {
+		body["changeOwnerDetails"] = map[string]interface{}{
+			"newOwner":    opts.ChangeOwnerAccountID,
+			"autofixName": opts.AutofixName,
+		}
 	}
-	if sharePermissions != nil {
-		body["sharePermissions"] = sharePermissions
+	if opts.SharePermissions != nil {
+		body["permissionDetails"] = map[string]interface{}{
+			"sharePermissions": opts.SharePermissions,
+		}
 	}
-	if extendAdminPermissions {
+	if opts.ExtendAdminPermissions {
 		body["extendAdminPermissions"] = true
 	}
 
@@ -438,6 +509,17 @@ func (s *DashboardsService) BulkEdit(ctx context.Context, action string, dashboa
 
 // BulkEditResult represents the result of a bulk edit operation.
 type BulkEditResult struct {
+	Action       string                          `json:"action,omitempty"`
+	EntityErrors map[string]*BulkEditActionError `json:"entityErrors,omitempty"`
+
+	// Deprecated: Jira does not return these fields; use EntityErrors.
 	SuccessfulDashboardIDs []string `json:"modifiedDashboards,omitempty"`
-	FailedDashboardIDs     []string `json:"notModifiedDashboards,omitempty"`
+	// Deprecated: Jira does not return these fields; use EntityErrors.
+	FailedDashboardIDs []string `json:"notModifiedDashboards,omitempty"`
+}
+
+// BulkEditActionError describes why a dashboard was not changed by BulkEdit.
+type BulkEditActionError struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty"`
 }
@@ -0,0 +1,104 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDashboardsService_BulkEdit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Method = %v, want %v", r.Method, http.MethodPut)
+		}
+
+		var body struct {
+			Action            string  `json:"action"`
+			EntityIDs         []int64 `json:"entityIds"`
+			PermissionDetails struct {
+				SharePermissions []*SharePermission `json:"sharePermissions"`
+			} `json:"permissionDetails"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if len(body.EntityIDs) != 2 || body.EntityIDs[1] != 10001 {
+			t.Errorf("entityIds = %v, want [10000 10001]", body.EntityIDs)
+		}
+		perms := body.PermissionDetails.SharePermissions
+		if len(perms) != 2 || perms[0].Group == nil || perms[0].Group.Name != "jira-users" || perms[1].Type != SharePermissionTypeAuthenticated {
+			t.Errorf("sharePermissions = %+v", perms)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"action":"changePermission","entityErrors":{"10001":{"errorMessages":["not owner"]}}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	perms := []*SharePermission{SharePermissionForGroup("jira-users"), SharePermissionForLoggedIn()}
+	result, _, err := client.Dashboards.BulkEdit(context.Background(), "changePermission", []string{"10000", "10001"}, "", perms, false)
+	if err != nil {
+		t.Fatalf("BulkEdit() error = %v", err)
+	}
+	if e := result.EntityErrors["10001"]; e == nil || len(e.ErrorMessages) != 1 {
+		t.Errorf("EntityErrors = %+v", result.EntityErrors)
+	}
+}
+
+func TestDashboardsService_BulkEdit_InvalidID(t *testing.T) {
+	client, _ := NewClient("https://example.atlassian.net")
+	_, _, err := client.Dashboards.BulkEdit(context.Background(), "changeOwner", []string{"abc"}, "5b10a2844c20165700ede21g", nil, false)
+	if err == nil {
+		t.Error("BulkEdit() expected error for non-numeric dashboard ID")
+	}
+}
+
+func TestDashboardsService_BulkEdit_ChangeOwner(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func(*Client) error
+		wantBody string
+	}{
+		{
+			name: "BulkEdit",
+			call: func(c *Client) error {
+				_, _, err := c.Dashboards.BulkEdit(context.Background(), "changeOwner", []string{"10000"}, "5b10a2844c20165700ede21g", nil, false)
+				return err
+			},
+			wantBody: `{"action":"changeOwner","changeOwnerDetails":{"autofixName":true,"newOwner":"5b10a2844c20165700ede21g"},"entityIds":[10000]}`,
+		},
+		{
+			name: "BulkEditWithOptions",
+			call: func(c *Client) error {
+				_, _, err := c.Dashboards.BulkEditWithOptions(context.Background(), "changeOwner", []string{"10000"}, &DashboardBulkEditOptions{
+					ChangeOwnerAccountID: "5b10a2844c20165700ede21g",
+				})
+				return err
+			},
+			wantBody: `{"action":"changeOwner","changeOwnerDetails":{"autofixName":false,"newOwner":"5b10a2844c20165700ede21g"},"entityIds":[10000]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body = %v, want %v", got, tt.wantBody)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"action":"changeOwner"}`))
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			if err := tt.call(client); err != nil {
+				t.Fatalf("%s() error = %v", tt.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package jira
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationFormat gives the working time Jira counts in a day and in a week
+// when it converts duration strings such as "1w 2d 3h 30m", used by time
+// tracking and worklogs, to and from seconds. Jira administrators can change
+// both lengths in the time tracking settings. A zero field uses the
+// corresponding DefaultDurationFormat value.
+type DurationFormat struct {
+	HoursPerDay float64
+	DaysPerWeek float64
+}
+
+// DefaultDurationFormat is Jira's default of 8 hour days and 5 day weeks. It is
+// used by ParseJiraDuration and FormatJiraDuration.
+var DefaultDurationFormat = DurationFormat{HoursPerDay: 8, DaysPerWeek: 5}
+
+// ParseJiraDuration parses a Jira duration string using DefaultDurationFormat.
+// See DurationFormat.Parse.
+func ParseJiraDuration(s string) (time.Duration, error) {
+	return DefaultDurationFormat.Parse(s)
+}
+
+// FormatJiraDuration formats d as a Jira duration string using
+// DefaultDurationFormat. See DurationFormat.Format.
+func FormatJiraDuration(d time.Duration) string {
+	return DefaultDurationFormat.Format(d)
+}
+
+// ParseJiraDuration parses a Jira duration string using the working day and
+// week lengths set by WithTimeTrackingConfig. See DurationFormat.Parse.
+func (c *Client) ParseJiraDuration(s string) (time.Duration, error) {
+	return c.durationFormat.Parse(s)
+}
+
+// FormatJiraDuration formats d as a Jira duration string using the working
+// day and week lengths set by WithTimeTrackingConfig. See
+// DurationFormat.Format.
+func (c *Client) FormatJiraDuration(d time.Duration) string {
+	return c.durationFormat.Format(d)
+}
+
+// Parse parses a Jira duration string: one or more numbers, each followed by a
+// unit of w, d, h or m, optionally separated by spaces, such as "1w 2d 3h" or
+// "1.5h". Units are case-insensitive. The result is rounded to the second.
+func (f DurationFormat) Parse(s string) (time.Duration, error) {
+	units := map[byte]time.Duration{
+		'w': f.week(),
+		'd': f.day(),
+		'h': time.Hour,
+		'm': time.Minute,
+	}
+
+	rest := strings.TrimSpace(s)
+	if rest == "" {
+		return 0, fmt.Errorf("invalid Jira duration %q: empty", s)
+	}
+
+	var total float64
+	for rest != "" {
+		end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		switch {
+		case end < 0:
+			return 0, fmt.Errorf("invalid Jira duration %q: missing unit after %q", s, rest)
+		case end == 0:
+			return 0, fmt.Errorf("invalid Jira duration %q: expected a number at %q", s, rest)
+		}
+		value, err := strconv.ParseFloat(rest[:end], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid Jira duration %q: bad number %q", s, rest[:end])
+		}
+		unit, ok := units[rest[end]|0x20]
+		if !ok {
+			return 0, fmt.Errorf("invalid Jira duration %q: unknown unit at %q, want w, d, h or m", s, rest[end:])
+		}
+		total += value * float64(unit)
+		rest = strings.TrimLeft(rest[end+1:], " ")
+	}
+
+	// float64(math.MaxInt64) rounds up to 2^63, which doesn't fit a Duration.
+	if total >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid Jira duration %q: out of range", s)
+	}
+	return time.Duration(total).Round(time.Second), nil
+}
+
+// Format formats d as a Jira duration string such as "1w 2d 3h 30m", rounded
+// to the nearest minute, the smallest unit Jira displays. Zero units are
+// omitted; a duration that rounds to zero is "0m". Negative durations are
+// prefixed with "-".
+func (f DurationFormat) Format(d time.Duration) string {
+	// Negate the minute count rather than d, which can't be negated when it
+	// is math.MinInt64.
+	minutes := int64(d.Round(time.Minute) / time.Minute)
+	if minutes == 0 {
+		return "0m"
+	}
+	sign := ""
+	if minutes < 0 {
+		sign = "-"
+		minutes = -minutes
+	}
+
+	var parts []string
+	for _, u := range []struct {
+		suffix  string
+		minutes int64
+	}{
+		{"w", int64(f.week() / time.Minute)},
+		{"d", int64(f.day() / time.Minute)},
+		{"h", 60},
+		{"m", 1},
+	} {
+		if u.minutes <= 0 {
+			continue
+		}
+		if n := minutes / u.minutes; n > 0 {
+			parts = append(parts, strconv.FormatInt(n, 10)+u.suffix)
+			minutes -= n * u.minutes
+		}
+	}
+	return sign + strings.Join(parts, " ")
+}
+
+// day returns the length of a working day, rounded to the minute.
+func (f DurationFormat) day() time.Duration {
+	hours := f.HoursPerDay
+	if hours <= 0 {
+		hours = DefaultDurationFormat.HoursPerDay
+	}
+	return time.Duration(hours * float64(time.Hour)).Round(time.Minute)
+}
+
+// week returns the length of a working week, rounded to the minute.
+func (f DurationFormat) week() time.Duration {
+	days := f.DaysPerWeek
+	if days <= 0 {
+		days = DefaultDurationFormat.DaysPerWeek
+	}
+	return time.Duration(days * float64(f.day())).Round(time.Minute)
+}
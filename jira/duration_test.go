@@ -0,0 +1,115 @@
+package jira
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseJiraDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"1w 2d 3h", (5*8 + 2*8 + 3) * time.Hour},
+		{"2h 30m", 2*time.Hour + 30*time.Minute},
+		{"2h30m", 2*time.Hour + 30*time.Minute},
+		{" 1D  4H ", 12 * time.Hour},
+		{"1.5h", 90 * time.Minute},
+		{"0.01m", time.Second},
+		{"0m", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseJiraDuration(tt.in)
+			if err != nil {
+				t.Fatalf("ParseJiraDuration(%q) error = %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseJiraDuration(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJiraDuration_Invalid(t *testing.T) {
+	for _, in := range []string{"", "   ", "3", "h", "2x", "2h 30", "-1h", "1..5h", "2h, 30m", "1y"} {
+		if got, err := ParseJiraDuration(in); err == nil {
+			t.Errorf("ParseJiraDuration(%q) = %v, want error", in, got)
+		}
+	}
+}
+
+func TestParseJiraDuration_OutOfRange(t *testing.T) {
+	// 64051.19470038039 weeks of 40 hours is exactly 2^63 nanoseconds.
+	for _, in := range []string{"64051.19470038039w", "64052w", "1000000000000w"} {
+		if got, err := ParseJiraDuration(in); err == nil {
+			t.Errorf("ParseJiraDuration(%q) = %v, want error", in, got)
+		}
+	}
+	if got, err := ParseJiraDuration("64051w"); err != nil || got <= 0 {
+		t.Errorf("ParseJiraDuration(%q) = %v, %v, want a positive duration", "64051w", got, err)
+	}
+}
+
+func TestFormatJiraDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{(5*8 + 2*8 + 3) * time.Hour, "1w 2d 3h"},
+		{2*time.Hour + 30*time.Minute, "2h 30m"},
+		{8 * time.Hour, "1d"},
+		{89*time.Minute + 31*time.Second, "1h 30m"},
+		{29 * time.Second, "0m"},
+		{0, "0m"},
+		{-90 * time.Minute, "-1h 30m"},
+		{-10 * time.Second, "0m"},
+		{math.MinInt64, "-64051w 7h 47m"},
+	}
+	for _, tt := range tests {
+		if got := FormatJiraDuration(tt.in); got != tt.want {
+			t.Errorf("FormatJiraDuration(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDurationFormat_Custom(t *testing.T) {
+	f := DurationFormat{HoursPerDay: 7.5, DaysPerWeek: 4}
+
+	got, err := f.Parse("1w 1d")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if want := 37*time.Hour + 30*time.Minute; got != want {
+		t.Errorf("Parse() = %v, want %v", got, want)
+	}
+	if s := f.Format(got + 45*time.Minute); s != "1w 1d 45m" {
+		t.Errorf("Format() = %q, want %q", s, "1w 1d 45m")
+	}
+
+	// Zero fields fall back to the defaults.
+	if s := (DurationFormat{}).Format(40 * time.Hour); s != "1w" {
+		t.Errorf("Format() = %q, want %q", s, "1w")
+	}
+}
+
+func TestClient_JiraDuration(t *testing.T) {
+	client, _ := NewClient("https://example.atlassian.net", WithTimeTrackingConfig(6, 4))
+
+	got, err := client.ParseJiraDuration("1d")
+	if err != nil {
+		t.Fatalf("ParseJiraDuration() error = %v", err)
+	}
+	if got != 6*time.Hour {
+		t.Errorf("ParseJiraDuration(1d) = %v, want %v", got, 6*time.Hour)
+	}
+	if s := client.FormatJiraDuration(32 * time.Hour); s != "1w 1d 2h" {
+		t.Errorf("FormatJiraDuration() = %q, want %q", s, "1w 1d 2h")
+	}
+
+	client, _ = NewClient("https://example.atlassian.net")
+	if got, _ := client.ParseJiraDuration("1d"); got != 8*time.Hour {
+		t.Errorf("default ParseJiraDuration(1d) = %v, want %v", got, 8*time.Hour)
+	}
+}
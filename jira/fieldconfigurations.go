@@ -0,0 +1,480 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// FieldConfigurationsService handles field configuration and field configuration
+// scheme operations for the Jira API.
+type FieldConfigurationsService struct {
+	client *Client
+}
+
+// FieldConfiguration represents a field configuration.
+type FieldConfiguration struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	IsDefault   bool   `json:"isDefault,omitempty"`
+}
+
+// FieldConfigurationListResult represents a paginated list of field configurations.
+type FieldConfigurationListResult struct {
+	Self       string                `json:"self,omitempty"`
+	NextPage   string                `json:"nextPage,omitempty"`
+	MaxResults int                   `json:"maxResults,omitempty"`
+	StartAt    int                   `json:"startAt,omitempty"`
+	Total      int                   `json:"total,omitempty"`
+	IsLast     bool                  `json:"isLast,omitempty"`
+	Values     []*FieldConfiguration `json:"values,omitempty"`
+}
+
+// FieldConfigurationListOptions specifies options for listing field configurations.
+type FieldConfigurationListOptions struct {
+	StartAt    int     `url:"startAt,omitempty"`
+	MaxResults int     `url:"maxResults,omitempty"`
+	IDs        []int64 `url:"id,omitempty"`
+	IsDefault  bool    `url:"isDefault,omitempty"`
+	Query      string  `url:"query,omitempty"`
+}
+
+// List returns field configurations with pagination.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfiguration-get
+func (s *FieldConfigurationsService) List(ctx context.Context, opts *FieldConfigurationListOptions) (*FieldConfigurationListResult, *Response, error) {
+	u := "/rest/api/3/fieldconfiguration"
+
+	if opts != nil {
+		params := url.Values{}
+		if opts.StartAt > 0 {
+			params.Set("startAt", strconv.Itoa(opts.StartAt))
+		}
+		if opts.MaxResults > 0 {
+			params.Set("maxResults", strconv.Itoa(opts.MaxResults))
+		}
+		for _, id := range opts.IDs {
+			params.Add("id", strconv.FormatInt(id, 10))
+		}
+		if opts.IsDefault {
+			params.Set("isDefault", "true")
+		}
+		if opts.Query != "" {
+			params.Set("query", opts.Query)
+		}
+		if len(params) > 0 {
+			u = fmt.Sprintf("%s?%s", u, params.Encode())
+		}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(FieldConfigurationListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// FieldConfigurationRequest represents a request to create or update a field configuration.
+type FieldConfigurationRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// Create creates a field configuration.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfiguration-post
+func (s *FieldConfigurationsService) Create(ctx context.Context, config *FieldConfigurationRequest) (*FieldConfiguration, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "/rest/api/3/fieldconfiguration", config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(FieldConfiguration)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// Update updates a field configuration.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfiguration-id-put
+func (s *FieldConfigurationsService) Update(ctx context.Context, configID int64, config *FieldConfigurationRequest) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/fieldconfiguration/%d", configID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Delete removes a field configuration.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfiguration-id-delete
+func (s *FieldConfigurationsService) Delete(ctx context.Context, configID int64) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/fieldconfiguration/%d", configID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// FieldConfigurationItem represents the configuration of a field within a field configuration.
+// IsHidden and IsRequired are pointers so that UpdateItems changes only the
+// flags that are set.
+type FieldConfigurationItem struct {
+	ID          string `json:"id"`
+	Description string `json:"description,omitempty"`
+	IsHidden    *bool  `json:"isHidden,omitempty"`
+	IsRequired  *bool  `json:"isRequired,omitempty"`
+	Renderer    string `json:"renderer,omitempty"`
+}
+
+// FieldConfigurationItemListResult represents a paginated list of field configuration items.
+type FieldConfigurationItemListResult struct {
+	Self       string                    `json:"self,omitempty"`
+	NextPage   string                    `json:"nextPage,omitempty"`
+	MaxResults int                       `json:"maxResults,omitempty"`
+	StartAt    int                       `json:"startAt,omitempty"`
+	Total      int                       `json:"total,omitempty"`
+	IsLast     bool                      `json:"isLast,omitempty"`
+	Values     []*FieldConfigurationItem `json:"values,omitempty"`
+}
+
+// ListItems returns the field items of a field configuration.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfiguration-id-fields-get
+func (s *FieldConfigurationsService) ListItems(ctx context.Context, configID int64, startAt, maxResults int) (*FieldConfigurationItemListResult, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/fieldconfiguration/%d/fields", configID)
+
+	params := url.Values{}
+	if startAt > 0 {
+		params.Set("startAt", strconv.Itoa(startAt))
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", strconv.Itoa(maxResults))
+	}
+	if len(params) > 0 {
+		u = fmt.Sprintf("%s?%s", u, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(FieldConfigurationItemListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// UpdateItems updates the required, hidden, description and renderer settings
+// of fields in a field configuration.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfiguration-id-fields-put
+func (s *FieldConfigurationsService) UpdateItems(ctx context.Context, configID int64, items []*FieldConfigurationItem) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/fieldconfiguration/%d/fields", configID)
+
+	body := map[string]any{
+		"fieldConfigurationItems": items,
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// FieldConfigurationScheme represents a field configuration scheme.
+type FieldConfigurationScheme struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// FieldConfigurationSchemeListResult represents a paginated list of field configuration schemes.
+type FieldConfigurationSchemeListResult struct {
+	Self       string                      `json:"self,omitempty"`
+	NextPage   string                      `json:"nextPage,omitempty"`
+	MaxResults int                         `json:"maxResults,omitempty"`
+	StartAt    int                         `json:"startAt,omitempty"`
+	Total      int                         `json:"total,omitempty"`
+	IsLast     bool                        `json:"isLast,omitempty"`
+	Values     []*FieldConfigurationScheme `json:"values,omitempty"`
+}
+
+// ListSchemes returns field configuration schemes.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfigurationscheme-get
+func (s *FieldConfigurationsService) ListSchemes(ctx context.Context, startAt, maxResults int, ids []int64) (*FieldConfigurationSchemeListResult, *Response, error) {
+	u := "/rest/api/3/fieldconfigurationscheme"
+
+	params := url.Values{}
+	if startAt > 0 {
+		params.Set("startAt", strconv.Itoa(startAt))
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", strconv.Itoa(maxResults))
+	}
+	for _, id := range ids {
+		params.Add("id", strconv.FormatInt(id, 10))
+	}
+	if len(params) > 0 {
+		u = fmt.Sprintf("%s?%s", u, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(FieldConfigurationSchemeListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// FieldConfigurationSchemeRequest represents a request to create or update a field configuration scheme.
+type FieldConfigurationSchemeRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateScheme creates a field configuration scheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfigurationscheme-post
+func (s *FieldConfigurationsService) CreateScheme(ctx context.Context, scheme *FieldConfigurationSchemeRequest) (*FieldConfigurationScheme, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "/rest/api/3/fieldconfigurationscheme", scheme)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(FieldConfigurationScheme)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// UpdateScheme updates a field configuration scheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfigurationscheme-id-put
+func (s *FieldConfigurationsService) UpdateScheme(ctx context.Context, schemeID int64, scheme *FieldConfigurationSchemeRequest) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/fieldconfigurationscheme/%d", schemeID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteScheme removes a field configuration scheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfigurationscheme-id-delete
+func (s *FieldConfigurationsService) DeleteScheme(ctx context.Context, schemeID int64) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/fieldconfigurationscheme/%d", schemeID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// FieldConfigurationIssueTypeItem maps an issue type to a field configuration within a scheme.
+type FieldConfigurationIssueTypeItem struct {
+	FieldConfigurationSchemeID string `json:"fieldConfigurationSchemeId,omitempty"`
+	FieldConfigurationID       string `json:"fieldConfigurationId,omitempty"`
+	IssueTypeID                string `json:"issueTypeId,omitempty"`
+}
+
+// FieldConfigurationIssueTypeItemListResult represents a paginated list of scheme mappings.
+type FieldConfigurationIssueTypeItemListResult struct {
+	Self       string                             `json:"self,omitempty"`
+	NextPage   string                             `json:"nextPage,omitempty"`
+	MaxResults int                                `json:"maxResults,omitempty"`
+	StartAt    int                                `json:"startAt,omitempty"`
+	Total      int                                `json:"total,omitempty"`
+	IsLast     bool                               `json:"isLast,omitempty"`
+	Values     []*FieldConfigurationIssueTypeItem `json:"values,omitempty"`
+}
+
+// ListSchemeMappings returns the issue type to field configuration mappings of schemes.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfigurationscheme-mapping-get
+func (s *FieldConfigurationsService) ListSchemeMappings(ctx context.Context, startAt, maxResults int, schemeIDs []int64) (*FieldConfigurationIssueTypeItemListResult, *Response, error) {
+	u := "/rest/api/3/fieldconfigurationscheme/mapping"
+
+	params := url.Values{}
+	if startAt > 0 {
+		params.Set("startAt", strconv.Itoa(startAt))
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", strconv.Itoa(maxResults))
+	}
+	for _, id := range schemeIDs {
+		params.Add("fieldConfigurationSchemeId", strconv.FormatInt(id, 10))
+	}
+	if len(params) > 0 {
+		u = fmt.Sprintf("%s?%s", u, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(FieldConfigurationIssueTypeItemListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// FieldConfigurationMapping maps an issue type to a field configuration.
+// Use "default" as the issue type ID to set the scheme's default field configuration.
+type FieldConfigurationMapping struct {
+	IssueTypeID          string `json:"issueTypeId"`
+	FieldConfigurationID string `json:"fieldConfigurationId"`
+}
+
+// SetSchemeMappings assigns field configurations to issue types in a scheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfigurationscheme-id-mapping-put
+func (s *FieldConfigurationsService) SetSchemeMappings(ctx context.Context, schemeID int64, mappings []*FieldConfigurationMapping) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/fieldconfigurationscheme/%d/mapping", schemeID)
+
+	body := map[string]any{
+		"mappings": mappings,
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// RemoveSchemeMappings removes issue type mappings from a scheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfigurationscheme-id-mapping-delete-post
+func (s *FieldConfigurationsService) RemoveSchemeMappings(ctx context.Context, schemeID int64, issueTypeIDs []string) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/fieldconfigurationscheme/%d/mapping/delete", schemeID)
+
+	body := map[string]any{
+		"issueTypeIds": issueTypeIDs,
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// FieldConfigurationSchemeProjects represents a scheme and the projects that use it.
+// A nil scheme means the projects use the default field configuration scheme.
+type FieldConfigurationSchemeProjects struct {
+	FieldConfigurationScheme *FieldConfigurationScheme `json:"fieldConfigurationScheme,omitempty"`
+	ProjectIDs               []string                  `json:"projectIds,omitempty"`
+}
+
+// FieldConfigurationSchemeProjectsListResult represents a paginated list of scheme project associations.
+type FieldConfigurationSchemeProjectsListResult struct {
+	Self       string                              `json:"self,omitempty"`
+	NextPage   string                              `json:"nextPage,omitempty"`
+	MaxResults int                                 `json:"maxResults,omitempty"`
+	StartAt    int                                 `json:"startAt,omitempty"`
+	Total      int                                 `json:"total,omitempty"`
+	IsLast     bool                                `json:"isLast,omitempty"`
+	Values     []*FieldConfigurationSchemeProjects `json:"values,omitempty"`
+}
+
+// ListSchemeProjects returns the field configuration schemes used by projects.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfigurationscheme-project-get
+func (s *FieldConfigurationsService) ListSchemeProjects(ctx context.Context, projectIDs []int64, startAt, maxResults int) (*FieldConfigurationSchemeProjectsListResult, *Response, error) {
+	u := "/rest/api/3/fieldconfigurationscheme/project"
+
+	params := url.Values{}
+	if startAt > 0 {
+		params.Set("startAt", strconv.Itoa(startAt))
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", strconv.Itoa(maxResults))
+	}
+	for _, id := range projectIDs {
+		params.Add("projectId", strconv.FormatInt(id, 10))
+	}
+	if len(params) > 0 {
+		u = fmt.Sprintf("%s?%s", u, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(FieldConfigurationSchemeProjectsListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// AssignSchemeToProject assigns a field configuration scheme to a project.
+// A schemeID of 0 assigns the default field configuration scheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-field-configurations/#api-rest-api-3-fieldconfigurationscheme-project-put
+func (s *FieldConfigurationsService) AssignSchemeToProject(ctx context.Context, schemeID, projectID int64) (*Response, error) {
+	body := map[string]any{
+		"projectId": strconv.FormatInt(projectID, 10),
+	}
+	if schemeID != 0 {
+		body["fieldConfigurationSchemeId"] = strconv.FormatInt(schemeID, 10)
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, "/rest/api/3/fieldconfigurationscheme/project", body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,163 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFieldConfigurationsService_List(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want := "/rest/api/3/fieldconfiguration"; r.URL.Path != want {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, want)
+		}
+		if got, want := r.URL.RawQuery, "id=10000&id=10001&isDefault=true&maxResults=50&query=bugs"; got != want {
+			t.Errorf("query = %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[{"id":10000,"name":"Bug fields","isDefault":true}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, _, err := client.FieldConfigurations.List(context.Background(), &FieldConfigurationListOptions{
+		MaxResults: 50,
+		IDs:        []int64{10000, 10001},
+		IsDefault:  true,
+		Query:      "bugs",
+	})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(result.Values) != 1 || result.Values[0].ID != 10000 || !result.Values[0].IsDefault {
+		t.Errorf("Values = %+v", result.Values)
+	}
+	if !result.IsLast {
+		t.Errorf("IsLast = %v, want true", result.IsLast)
+	}
+}
+
+func TestFieldConfigurationsService_Requests(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(*Client) (*Response, error)
+		wantMethod string
+		wantPath   string
+		wantBody   string
+	}{
+		{
+			name: "UpdateItems",
+			call: func(c *Client) (*Response, error) {
+				return c.FieldConfigurations.UpdateItems(context.Background(), 10000, []*FieldConfigurationItem{
+					{ID: "environment", IsRequired: Bool(true)},
+				})
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/rest/api/3/fieldconfiguration/10000/fields",
+			wantBody:   `{"fieldConfigurationItems":[{"id":"environment","isRequired":true}]}`,
+		},
+		{
+			name: "UpdateItems show",
+			call: func(c *Client) (*Response, error) {
+				return c.FieldConfigurations.UpdateItems(context.Background(), 10000, []*FieldConfigurationItem{
+					{ID: "labels", IsHidden: Bool(false)},
+				})
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/rest/api/3/fieldconfiguration/10000/fields",
+			wantBody:   `{"fieldConfigurationItems":[{"id":"labels","isHidden":false}]}`,
+		},
+		{
+			name: "SetSchemeMappings",
+			call: func(c *Client) (*Response, error) {
+				return c.FieldConfigurations.SetSchemeMappings(context.Background(), 10100, []*FieldConfigurationMapping{
+					{IssueTypeID: "default", FieldConfigurationID: "10000"},
+				})
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/rest/api/3/fieldconfigurationscheme/10100/mapping",
+			wantBody:   `{"mappings":[{"issueTypeId":"default","fieldConfigurationId":"10000"}]}`,
+		},
+		{
+			name: "RemoveSchemeMappings",
+			call: func(c *Client) (*Response, error) {
+				return c.FieldConfigurations.RemoveSchemeMappings(context.Background(), 10100, []string{"10001"})
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/rest/api/3/fieldconfigurationscheme/10100/mapping/delete",
+			wantBody:   `{"issueTypeIds":["10001"]}`,
+		},
+		{
+			name: "AssignSchemeToProject",
+			call: func(c *Client) (*Response, error) {
+				return c.FieldConfigurations.AssignSchemeToProject(context.Background(), 10100, 10200)
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/rest/api/3/fieldconfigurationscheme/project",
+			wantBody:   `{"fieldConfigurationSchemeId":"10100","projectId":"10200"}`,
+		},
+		{
+			name: "AssignSchemeToProject default",
+			call: func(c *Client) (*Response, error) {
+				return c.FieldConfigurations.AssignSchemeToProject(context.Background(), 0, 10200)
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/rest/api/3/fieldconfigurationscheme/project",
+			wantBody:   `{"projectId":"10200"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.wantMethod {
+					t.Errorf("Method = %v, want %v", r.Method, tt.wantMethod)
+				}
+				if r.URL.Path != tt.wantPath {
+					t.Errorf("URL path = %v, want %v", r.URL.Path, tt.wantPath)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body = %v, want %v", got, tt.wantBody)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			if _, err := tt.call(client); err != nil {
+				t.Errorf("%s() error = %v", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestFieldConfigurationsService_ListSchemeProjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.RawQuery, "projectId=10200&projectId=10201"; got != want {
+			t.Errorf("query = %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"isLast":true,"values":[` +
+			`{"fieldConfigurationScheme":{"id":"10100","name":"Bug scheme"},"projectIds":["10200"]},` +
+			`{"projectIds":["10201"]}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, _, err := client.FieldConfigurations.ListSchemeProjects(context.Background(), []int64{10200, 10201}, 0, 0)
+	if err != nil {
+		t.Fatalf("ListSchemeProjects() error = %v", err)
+	}
+	if len(result.Values) != 2 {
+		t.Fatalf("len(Values) = %v, want 2", len(result.Values))
+	}
+	if s := result.Values[0].FieldConfigurationScheme; s == nil || s.ID != "10100" {
+		t.Errorf("Values[0].FieldConfigurationScheme = %+v, want ID 10100", s)
+	}
+	if result.Values[1].FieldConfigurationScheme != nil {
+		t.Errorf("Values[1].FieldConfigurationScheme = %+v, want nil for the default scheme", result.Values[1].FieldConfigurationScheme)
+	}
+}
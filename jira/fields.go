@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -63,6 +64,111 @@ func (s *FieldsService) List(ctx context.Context) ([]*Field, *Response, error) {
 	return fields, resp, nil
 }
 
+// Map returns every field keyed by display name. The field list is fetched
+// once and reused by later calls to Map and ResolveID on the same client,
+// until this service creates, updates, deletes, trashes or restores a field.
+// If several fields share a name, the map holds the first Jira lists; use
+// ResolveID to detect such names.
+func (s *FieldsService) Map(ctx context.Context) (map[string]*Field, error) {
+	fields, err := s.cachedFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*Field, len(fields))
+	for _, f := range fields {
+		if _, ok := byName[f.Name]; !ok {
+			byName[f.Name] = f
+		}
+	}
+
+	return byName, nil
+}
+
+// ResolveID returns the ID of the field with the given display name, such as
+// "customfield_10010" for "Sprint", so that custom field IDs need not be
+// hard-coded. It returns an error if no field or more than one has that
+// name. The field list is cached as described for Map.
+func (s *FieldsService) ResolveID(ctx context.Context, name string) (string, error) {
+	fields, err := s.cachedFields(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, f := range fields {
+		if f.Name == name {
+			ids = append(ids, f.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no field named %q", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("field name %q is ambiguous: %s", name, strings.Join(ids, ", "))
+	}
+}
+
+// cachedFields returns copies of the fields in the client's cached field
+// list, fetching it first if needed. The fetch runs without holding fieldsMu,
+// so concurrent callers may each fetch the list; a list fetched across a
+// change to a field is returned but not cached.
+func (s *FieldsService) cachedFields(ctx context.Context) ([]*Field, error) {
+	s.client.fieldsMu.Lock()
+	fields, gen := s.client.fields, s.client.fieldsGen
+	s.client.fieldsMu.Unlock()
+
+	if fields == nil {
+		var err error
+		fields, _, err = s.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.client.fieldsMu.Lock()
+		if s.client.fieldsGen == gen {
+			s.client.fields = fields
+		}
+		s.client.fieldsMu.Unlock()
+	}
+
+	copies := make([]*Field, len(fields))
+	for i, f := range fields {
+		copies[i] = f.clone()
+	}
+	return copies, nil
+}
+
+// forgetCachedFields drops the cached field list after a field changes.
+func (s *FieldsService) forgetCachedFields() {
+	s.client.fieldsMu.Lock()
+	s.client.fields = nil
+	s.client.fieldsGen++
+	s.client.fieldsMu.Unlock()
+}
+
+// clone returns a copy of f that callers can modify without changing f. The
+// project of its scope is copied shallowly.
+func (f *Field) clone() *Field {
+	c := *f
+	c.ClauseNames = slices.Clone(f.ClauseNames)
+	if f.Schema != nil {
+		schema := *f.Schema
+		c.Schema = &schema
+	}
+	if f.Scope != nil {
+		scope := *f.Scope
+		if f.Scope.Project != nil {
+			project := *f.Scope.Project
+			scope.Project = &project
+		}
+		c.Scope = &scope
+	}
+	return &c
+}
+
 // FieldCreateRequest represents a request to create a custom field.
 type FieldCreateRequest struct {
 	Name        string `json:"name"`
@@ -80,6 +186,7 @@ func (s *FieldsService) Create(ctx context.Context, field *FieldCreateRequest) (
 
 	result := new(Field)
 	resp, err := s.client.Do(req, result)
+	s.forgetCachedFields()
 	if err != nil {
 		return nil, resp, err
 	}
@@ -103,7 +210,9 @@ func (s *FieldsService) Update(ctx context.Context, fieldID string, field *Field
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	resp, err := s.client.Do(req, nil)
+	s.forgetCachedFields()
+	return resp, err
 }
 
 // Delete removes a custom field.
@@ -115,7 +224,9 @@ func (s *FieldsService) Delete(ctx context.Context, fieldID string) (*Response,
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	resp, err := s.client.Do(req, nil)
+	s.forgetCachedFields()
+	return resp, err
 }
 
 // FieldListResult represents a paginated list of fields.
@@ -195,7 +306,9 @@ func (s *FieldsService) Trash(ctx context.Context, fieldID string) (*Response, e
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	resp, err := s.client.Do(req, nil)
+	s.forgetCachedFields()
+	return resp, err
 }
 
 // Restore restores a custom field from the trash.
@@ -207,7 +320,9 @@ func (s *FieldsService) Restore(ctx context.Context, fieldID string) (*Response,
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	resp, err := s.client.Do(req, nil)
+	s.forgetCachedFields()
+	return resp, err
 }
 
 // Context represents a custom field context.
@@ -326,6 +441,56 @@ func (s *FieldsService) DeleteContext(ctx context.Context, fieldID string, conte
 	return s.client.Do(req, nil)
 }
 
+// AssignContextToProjects adds projects to a field context, which stops it
+// being global if it was.
+func (s *FieldsService) AssignContextToProjects(ctx context.Context, fieldID string, contextID int64, projectIDs []string) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/field/%s/context/%d/project", fieldID, contextID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, map[string][]string{"projectIds": projectIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// RemoveContextFromProject removes projects from a field context. Jira
+// rejects removing the last project, since that would make it global.
+func (s *FieldsService) RemoveContextFromProject(ctx context.Context, fieldID string, contextID int64, projectIDs []string) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/field/%s/context/%d/project/remove", fieldID, contextID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, map[string][]string{"projectIds": projectIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// AddIssueTypesToContext adds issue types to a field context.
+func (s *FieldsService) AddIssueTypesToContext(ctx context.Context, fieldID string, contextID int64, issueTypeIDs []string) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/field/%s/context/%d/issuetype", fieldID, contextID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, map[string][]string{"issueTypeIds": issueTypeIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// RemoveIssueTypesFromContext removes issue types from a field context.
+func (s *FieldsService) RemoveIssueTypesFromContext(ctx context.Context, fieldID string, contextID int64, issueTypeIDs []string) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/field/%s/context/%d/issuetype/remove", fieldID, contextID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, map[string][]string{"issueTypeIds": issueTypeIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // FieldOption represents a custom field option.
 type FieldOption struct {
 	ID       string `json:"id,omitempty"`
@@ -435,3 +600,27 @@ func (s *FieldsService) DeleteContextOption(ctx context.Context, fieldID string,
 
 	return s.client.Do(req, nil)
 }
+
+// ReorderContextOptions moves options of a field context, keeping their
+// relative order. Set position to "First" or "Last", or set after to the ID
+// of the option they should follow.
+func (s *FieldsService) ReorderContextOptions(ctx context.Context, fieldID string, contextID int64, optionIDs []string, position, after string) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/field/%s/context/%d/option/move", fieldID, contextID)
+
+	body := map[string]interface{}{
+		"customFieldOptionIds": optionIDs,
+	}
+	if position != "" {
+		body["position"] = position
+	}
+	if after != "" {
+		body["after"] = after
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,177 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFieldsService_ContextScope(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(*Client) (*Response, error)
+		wantMethod string
+		wantPath   string
+		wantBody   string
+	}{
+		{
+			name: "AssignContextToProjects",
+			call: func(c *Client) (*Response, error) {
+				return c.Fields.AssignContextToProjects(context.Background(), "customfield_10010", 10100, []string{"10000", "10001"})
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/rest/api/3/field/customfield_10010/context/10100/project",
+			wantBody:   `{"projectIds":["10000","10001"]}`,
+		},
+		{
+			name: "RemoveContextFromProject",
+			call: func(c *Client) (*Response, error) {
+				return c.Fields.RemoveContextFromProject(context.Background(), "customfield_10010", 10100, []string{"10001"})
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/rest/api/3/field/customfield_10010/context/10100/project/remove",
+			wantBody:   `{"projectIds":["10001"]}`,
+		},
+		{
+			name: "AddIssueTypesToContext",
+			call: func(c *Client) (*Response, error) {
+				return c.Fields.AddIssueTypesToContext(context.Background(), "customfield_10010", 10100, []string{"10004"})
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/rest/api/3/field/customfield_10010/context/10100/issuetype",
+			wantBody:   `{"issueTypeIds":["10004"]}`,
+		},
+		{
+			name: "RemoveIssueTypesFromContext",
+			call: func(c *Client) (*Response, error) {
+				return c.Fields.RemoveIssueTypesFromContext(context.Background(), "customfield_10010", 10100, []string{"10004", "10005"})
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/rest/api/3/field/customfield_10010/context/10100/issuetype/remove",
+			wantBody:   `{"issueTypeIds":["10004","10005"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.wantMethod {
+					t.Errorf("Method = %v, want %v", r.Method, tt.wantMethod)
+				}
+				if r.URL.Path != tt.wantPath {
+					t.Errorf("URL path = %v, want %v", r.URL.Path, tt.wantPath)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body = %v, want %v", got, tt.wantBody)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			if _, err := tt.call(client); err != nil {
+				t.Errorf("%s() error = %v", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestFieldsService_ReorderContextOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		position string
+		after    string
+		wantBody string
+	}{
+		{"position", "First", "", `{"customFieldOptionIds":["10001","10002"],"position":"First"}`},
+		{"after", "", "10003", `{"after":"10003","customFieldOptionIds":["10001","10002"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("Method = %v, want %v", r.Method, http.MethodPut)
+				}
+				if want := "/rest/api/3/field/customfield_10010/context/10100/option/move"; r.URL.Path != want {
+					t.Errorf("URL path = %v, want %v", r.URL.Path, want)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body = %v, want %v", got, tt.wantBody)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			_, err := client.Fields.ReorderContextOptions(context.Background(), "customfield_10010", 10100, []string{"10001", "10002"}, tt.position, tt.after)
+			if err != nil {
+				t.Errorf("ReorderContextOptions() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestFieldsService_ResolveID(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /rest/api/3/field":
+			requests++
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`[{"id":"summary","name":"Summary"},{"id":"customfield_10010","name":"Sprint","custom":true},` +
+				`{"id":"customfield_10020","name":"Team","custom":true},{"id":"customfield_10021","name":"Team","custom":true}]`))
+		case "PUT /rest/api/3/field/customfield_10020":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+
+	id, err := client.Fields.ResolveID(ctx, "Sprint")
+	if err != nil {
+		t.Fatalf("ResolveID() error = %v", err)
+	}
+	if id != "customfield_10010" {
+		t.Errorf("ResolveID() = %v, want %v", id, "customfield_10010")
+	}
+
+	if _, err := client.Fields.ResolveID(ctx, "Team"); err == nil || !strings.Contains(err.Error(), "customfield_10021") {
+		t.Errorf("ResolveID(Team) error = %v, want an ambiguity error naming both IDs", err)
+	}
+	if _, err := client.Fields.ResolveID(ctx, "Nope"); err == nil {
+		t.Error("ResolveID(Nope) error = nil, want error")
+	}
+
+	fields, err := client.Fields.Map(ctx)
+	if err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	if fields["Summary"] == nil || fields["Summary"].ID != "summary" || len(fields) != 3 {
+		t.Errorf("Map() = %v, want 3 names including Summary", fields)
+	}
+	fields["Sprint"].ID = "changed"
+	if id, _ := client.Fields.ResolveID(ctx, "Sprint"); id != "customfield_10010" {
+		t.Errorf("ResolveID() after changing a Map field = %v, want %v", id, "customfield_10010")
+	}
+	if requests != 1 {
+		t.Errorf("field list requests = %v, want 1", requests)
+	}
+
+	if _, err := client.Fields.Update(ctx, "customfield_10020", &FieldUpdateRequest{Name: "Squad"}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if _, err := client.Fields.Map(ctx); err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("field list requests after Update = %v, want 2", requests)
+	}
+}
@@ -2,6 +2,7 @@ package jira
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -42,7 +43,104 @@ type SharePermission struct {
 	User    *User        `json:"user,omitempty"`
 }
 
-// FilterSubscription represents a filter subscription.
+// Share permission types.
+const (
+	SharePermissionTypeUser        = "user"
+	SharePermissionTypeGroup       = "group"
+	SharePermissionTypeProject     = "project"
+	SharePermissionTypeProjectRole = "projectRole"
+	SharePermissionTypeGlobal      = "global"
+
+	// SharePermissionTypeLoggedIn is how Jira reports a share with all
+	// logged-in users. Requests must use SharePermissionTypeAuthenticated.
+	SharePermissionTypeLoggedIn      = "loggedin"
+	SharePermissionTypeAuthenticated = "authenticated"
+)
+
+// The following constructors build share permissions for the
+// SharePermissions and EditPermissions of filters and dashboards.
+
+// SharePermissionForUser shares with a single user.
+func SharePermissionForUser(accountID string) *SharePermission {
+	return &SharePermission{Type: SharePermissionTypeUser, User: &User{AccountID: accountID}}
+}
+
+// SharePermissionForGroup shares with the members of a group, identified by name.
+func SharePermissionForGroup(name string) *SharePermission {
+	return &SharePermission{Type: SharePermissionTypeGroup, Group: &Group{Name: name}}
+}
+
+// SharePermissionForProject shares with users who can browse a project.
+func SharePermissionForProject(projectID string) *SharePermission {
+	return &SharePermission{Type: SharePermissionTypeProject, Project: &Project{ID: projectID}}
+}
+
+// SharePermissionForProjectRole shares with the members of a role in a project.
+func SharePermissionForProjectRole(projectID string, roleID int64) *SharePermission {
+	return &SharePermission{
+		Type:    SharePermissionTypeProjectRole,
+		Project: &Project{ID: projectID},
+		Role:    &ProjectRole{ID: roleID},
+	}
+}
+
+// SharePermissionForLoggedIn shares with every logged-in user.
+func SharePermissionForLoggedIn() *SharePermission {
+	return &SharePermission{Type: SharePermissionTypeAuthenticated}
+}
+
+// SharePermissionForGlobal shares with everyone, including anonymous users
+// where the site allows it.
+func SharePermissionForGlobal() *SharePermission {
+	return &SharePermission{Type: SharePermissionTypeGlobal}
+}
+
+// Validate checks that the permission's type matches the sub-object it
+// carries, such as a Project with an ID for SharePermissionTypeProject.
+// Jira answers mismatches with an unhelpful "invalid share permission" 400.
+// Types without a SharePermissionType constant, such as "project-unknown",
+// are passed through unchecked.
+func (p *SharePermission) Validate() error {
+	if p == nil {
+		return errors.New("share permission is nil")
+	}
+	switch p.Type {
+	case "":
+		return errors.New("share permission has no type")
+	case SharePermissionTypeUser:
+		if p.User == nil || p.User.AccountID == "" {
+			return fmt.Errorf("share permission of type %q requires a user account ID", p.Type)
+		}
+	case SharePermissionTypeGroup:
+		if p.Group == nil || (p.Group.Name == "" && p.Group.GroupID == "") {
+			return fmt.Errorf("share permission of type %q requires a group name or ID", p.Type)
+		}
+	case SharePermissionTypeProject:
+		if p.Project == nil || p.Project.ID == "" {
+			return fmt.Errorf("share permission of type %q requires a project ID", p.Type)
+		}
+	case SharePermissionTypeProjectRole:
+		if p.Project == nil || p.Project.ID == "" || p.Role == nil || p.Role.ID == 0 {
+			return fmt.Errorf("share permission of type %q requires a project ID and a role ID", p.Type)
+		}
+	}
+	return nil
+}
+
+// validateSharePermissions validates each permission in the given lists.
+func validateSharePermissions(lists ...[]*SharePermission) error {
+	for _, list := range lists {
+		for i, p := range list {
+			if err := p.Validate(); err != nil {
+				return fmt.Errorf("share permission %d: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// FilterSubscription represents a filter subscription. Jira's REST API only
+// exposes subscriptions for reading; they are created and removed in the UI.
 type FilterSubscription struct {
 	ID    int64  `json:"id,omitempty"`
 	User  *User  `json:"user,omitempty"`
@@ -71,6 +169,13 @@ type FilterUpdateRequest struct {
 
 // Create creates a new filter.
 func (s *FiltersService) Create(ctx context.Context, filter *FilterCreateRequest, expand []string, overrideSharePermissions bool) (*Filter, *Response, error) {
+	if filter == nil {
+		return nil, nil, errors.New("filter request is nil")
+	}
+	if err := validateSharePermissions(filter.SharePermissions, filter.EditPermissions); err != nil {
+		return nil, nil, err
+	}
+
 	u := "/rest/api/3/filter"
 
 	params := url.Values{}
@@ -135,8 +240,42 @@ func (s *FiltersService) Get(ctx context.Context, filterID int64, opts *FilterGe
 	return filter, resp, nil
 }
 
+// ListSubscriptions returns the subscriptions of a filter.
+//
+// The REST API has no endpoints for adding or removing subscriptions, so
+// scheduled filter emails can only be inspected here, not managed.
+func (s *FiltersService) ListSubscriptions(ctx context.Context, filterID int64) ([]*FilterSubscription, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/filter/%d?expand=subscriptions", filterID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Jira wraps expanded subscriptions in a paged list, which doesn't fit
+	// Filter.Subscriptions, so decode just that part here.
+	var result struct {
+		Subscriptions struct {
+			Items []*FilterSubscription `json:"items"`
+		} `json:"subscriptions"`
+	}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Subscriptions.Items, resp, nil
+}
+
 // Update updates a filter.
 func (s *FiltersService) Update(ctx context.Context, filterID int64, filter *FilterUpdateRequest, expand []string, overrideSharePermissions bool) (*Filter, *Response, error) {
+	if filter == nil {
+		return nil, nil, errors.New("filter request is nil")
+	}
+	if err := validateSharePermissions(filter.SharePermissions, filter.EditPermissions); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("/rest/api/3/filter/%d", filterID)
 
 	params := url.Values{}
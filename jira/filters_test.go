@@ -0,0 +1,90 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFiltersService_ListSubscriptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/filter/10000" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/filter/10000")
+		}
+		if got := r.URL.Query().Get("expand"); got != "subscriptions" {
+			t.Errorf("expand = %v, want %v", got, "subscriptions")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"10000","subscriptions":{"size":2,"items":[{"id":1,"group":{"name":"jira-users"}},{"id":2,"user":{"accountId":"abc"}}]}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	subs, _, err := client.Filters.ListSubscriptions(context.Background(), 10000)
+	if err != nil {
+		t.Fatalf("ListSubscriptions() error = %v", err)
+	}
+	if len(subs) != 2 || subs[0].Group.Name != "jira-users" || subs[1].User.AccountID != "abc" {
+		t.Errorf("subscriptions = %+v", subs)
+	}
+}
+
+func TestSharePermission_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		perm    *SharePermission
+		wantErr bool
+	}{
+		{"group", SharePermissionForGroup("jira-users"), false},
+		{"project role", SharePermissionForProjectRole("10000", 10002), false},
+		{"logged in", SharePermissionForLoggedIn(), false},
+		{"global", SharePermissionForGlobal(), false},
+		{"project without project", &SharePermission{Type: SharePermissionTypeProject}, true},
+		{"project role without role", &SharePermission{Type: SharePermissionTypeProjectRole, Project: &Project{ID: "10000"}}, true},
+		{"user without account", &SharePermission{Type: SharePermissionTypeUser, User: &User{}}, true},
+		{"unknown type", &SharePermission{Type: "project-unknown"}, false},
+		{"no type", &SharePermission{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.perm.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFiltersService_Create_InvalidSharePermission(t *testing.T) {
+	client, _ := NewClient("https://example.atlassian.net")
+	filter := &FilterCreateRequest{
+		Name:             "My filter",
+		SharePermissions: []*SharePermission{SharePermissionForGroup("jira-users"), {Type: SharePermissionTypeProject}},
+	}
+	_, _, err := client.Filters.Create(context.Background(), filter, nil, false)
+	if err == nil {
+		t.Error("Create() expected error for project permission without a project")
+	}
+}
+
+func TestFiltersAndDashboards_NilRequest(t *testing.T) {
+	client, _ := NewClient("https://example.atlassian.net")
+	ctx := context.Background()
+	if _, _, err := client.Filters.Create(ctx, nil, nil, false); err == nil {
+		t.Error("Filters.Create(nil) error = nil, want error")
+	}
+	if _, _, err := client.Filters.Update(ctx, 10000, nil, nil, false); err == nil {
+		t.Error("Filters.Update(nil) error = nil, want error")
+	}
+	if _, _, err := client.Dashboards.Create(ctx, nil); err == nil {
+		t.Error("Dashboards.Create(nil) error = nil, want error")
+	}
+	if _, _, err := client.Dashboards.Update(ctx, "10000", nil); err == nil {
+		t.Error("Dashboards.Update(nil) error = nil, want error")
+	}
+	if _, _, err := client.Dashboards.Copy(ctx, "10000", nil); err == nil {
+		t.Error("Dashboards.Copy(nil) error = nil, want error")
+	}
+}
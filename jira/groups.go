@@ -103,7 +103,7 @@ type GroupBulkResult struct {
 	Values     []*Group `json:"values,omitempty"`
 }
 
-// BulkGetOptions specifies options for bulk getting groups.
+// GroupBulkGetOptions specifies options for bulk getting groups.
 type GroupBulkGetOptions struct {
 	StartAt    int      `url:"startAt,omitempty"`
 	MaxResults int      `url:"maxResults,omitempty"`
@@ -196,6 +196,40 @@ func (s *GroupsService) GetMembers(ctx context.Context, groupName string, opts *
 	return result, resp, nil
 }
 
+// ListMembers returns a page of the members of the group with the given ID.
+// Unlike GetMembers, it identifies the group by its ID, which stays the same
+// when the group is renamed. Pass startAt and maxResults of zero for the
+// server defaults; Paginate can walk every page.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-member-get
+func (s *GroupsService) ListMembers(ctx context.Context, groupID string, includeInactive bool, startAt, maxResults int) (*GroupMembersResult, *Response, error) {
+	params := url.Values{}
+	params.Set("groupId", groupID)
+	if includeInactive {
+		params.Set("includeInactiveUsers", "true")
+	}
+	if startAt > 0 {
+		params.Set("startAt", strconv.Itoa(startAt))
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", strconv.Itoa(maxResults))
+	}
+	u := fmt.Sprintf("/rest/api/3/group/member?%s", params.Encode())
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(GroupMembersResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
 // AddUserRequest represents a request to add a user to a group.
 type AddUserRequest struct {
 	AccountID string `json:"accountId,omitempty"`
@@ -222,7 +256,7 @@ func (s *GroupsService) AddUser(ctx context.Context, groupName, accountID string
 
 // RemoveUser removes a user from a group.
 func (s *GroupsService) RemoveUser(ctx context.Context, groupName, accountID string) (*Response, error) {
-	u := fmt.Sprintf("/rest/api/3/group/user?groupname=%s&accountId=%s", url.QueryEscape(groupName), accountID)
+	u := fmt.Sprintf("/rest/api/3/group/user?groupname=%s&accountId=%s", url.QueryEscape(groupName), url.QueryEscape(accountID))
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
 	if err != nil {
@@ -0,0 +1,170 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGroupsService_ListMembers(t *testing.T) {
+	members := []*User{{AccountID: "a1"}, {AccountID: "a2"}, {AccountID: "a3"}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/group/member" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/group/member")
+		}
+		q := r.URL.Query()
+		if got := q.Get("groupId"); got != "g-1" {
+			t.Errorf("groupId = %v, want %v", got, "g-1")
+		}
+		if got := q.Get("includeInactiveUsers"); got != "true" {
+			t.Errorf("includeInactiveUsers = %v, want %v", got, "true")
+		}
+		if q.Has("groupname") {
+			t.Errorf("groupname = %v, want unset", q.Get("groupname"))
+		}
+
+		startAt, _ := strconv.Atoi(q.Get("startAt"))
+		end := min(startAt+2, len(members))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(GroupMembersResult{
+			StartAt:    startAt,
+			MaxResults: 2,
+			Total:      len(members),
+			IsLast:     end == len(members),
+			Values:     members[startAt:end],
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+	pages := Paginate(ctx, func(startAt int) ([]*User, *Response, error) {
+		result, resp, err := client.Groups.ListMembers(ctx, "g-1", true, startAt, 2)
+		if err != nil {
+			return nil, resp, err
+		}
+		return result.Values, resp, nil
+	})
+
+	var ids []string
+	for user, err := range pages {
+		if err != nil {
+			t.Fatalf("ListMembers() error = %v", err)
+		}
+		ids = append(ids, user.AccountID)
+	}
+	if got := strings.Join(ids, ","); got != "a1,a2,a3" {
+		t.Errorf("members = %v, want %v", got, "a1,a2,a3")
+	}
+}
+
+func TestGroupsService_AddRemoveUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/group/user" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/group/user")
+		}
+		if got := r.URL.Query().Get("groupname"); got != "jira devs" {
+			t.Errorf("groupname = %v, want %v", got, "jira devs")
+		}
+
+		switch r.Method {
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := strings.TrimSpace(string(body)), `{"accountId":"557058:abc"}`; got != want {
+				t.Errorf("body = %v, want %v", got, want)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"name":"jira devs","groupId":"g-1"}`))
+		case http.MethodDelete:
+			if got := r.URL.Query().Get("accountId"); got != "557058:abc" {
+				t.Errorf("accountId = %v, want %v", got, "557058:abc")
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("Method = %v", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	group, _, err := client.Groups.AddUser(context.Background(), "jira devs", "557058:abc")
+	if err != nil {
+		t.Fatalf("AddUser() error = %v", err)
+	}
+	if group.GroupID != "g-1" {
+		t.Errorf("GroupID = %v, want %v", group.GroupID, "g-1")
+	}
+	if _, err := client.Groups.RemoveUser(context.Background(), "jira devs", "557058:abc"); err != nil {
+		t.Errorf("RemoveUser() error = %v", err)
+	}
+}
+
+func TestGroupsService_BulkGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/group/bulk" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/group/bulk")
+		}
+		want := "groupId=g-1&groupId=g-2&groupName=jira+devs&maxResults=2&startAt=2"
+		if r.URL.RawQuery != want {
+			t.Errorf("query = %v, want %v", r.URL.RawQuery, want)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"startAt":2,"maxResults":2,"total":3,"isLast":true,"values":[{"name":"jira devs","groupId":"g-3"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, _, err := client.Groups.BulkGet(context.Background(), &GroupBulkGetOptions{
+		StartAt:    2,
+		MaxResults: 2,
+		GroupIDs:   []string{"g-1", "g-2"},
+		GroupNames: []string{"jira devs"},
+	})
+	if err != nil {
+		t.Fatalf("BulkGet() error = %v", err)
+	}
+	if !result.IsLast || result.Total != 3 || len(result.Values) != 1 {
+		t.Errorf("result = %+v, want the last page of 3 with one group", result)
+	}
+}
+
+func TestGroupsService_Find(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/groups/picker" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/groups/picker")
+		}
+		want := "caseInsensitive=true&exclude=jira-admins&exclude=site+admins&maxResults=5&query=dev+%26+ops"
+		if r.URL.RawQuery != want {
+			t.Errorf("query = %v, want %v", r.URL.RawQuery, want)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"header":"Showing 1 of 1 matching groups","total":1,"groups":[` +
+			`{"name":"dev & ops","html":"<b>dev &amp; ops</b>","groupId":"g-7"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	found, _, err := client.Groups.Find(context.Background(), &FindGroupsOptions{
+		Query:           "dev & ops",
+		Exclude:         []string{"jira-admins", "site admins"},
+		MaxResults:      5,
+		CaseInsensitive: true,
+	})
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if len(found.Groups) != 1 {
+		t.Fatalf("len(Groups) = %v, want 1", len(found.Groups))
+	}
+	if g := found.Groups[0]; g.GroupID != "g-7" || g.HTML != "<b>dev &amp; ops</b>" {
+		t.Errorf("group = %+v, want g-7 with highlighted HTML", g)
+	}
+}
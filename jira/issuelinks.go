@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // IssueLinksService handles issue link operations for the Jira API.
@@ -76,12 +77,21 @@ type IssueRef struct {
 
 // CommentRef represents a comment reference for link creation.
 type CommentRef struct {
-	Body       interface{} `json:"body,omitempty"` // Can be string or ADF
+	Body       interface{} `json:"body,omitempty"` // Plain text string or ADF document, such as an *adf.Node
 	Visibility *Visibility `json:"visibility,omitempty"`
 }
 
-// Create creates an issue link.
+// Create creates an issue link, optionally with a comment. A plain text
+// comment body is sent as an ADF document.
 func (s *IssueLinksService) Create(ctx context.Context, link *IssueLinkCreateRequest) (*Response, error) {
+	if link != nil && link.Comment != nil {
+		comment := *link.Comment
+		comment.Body = adfBody(comment.Body)
+		l := *link
+		l.Comment = &comment
+		link = &l
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodPost, "/rest/api/3/issueLink", link)
 	if err != nil {
 		return nil, err
@@ -101,3 +111,96 @@ func (s *IssueLinksService) Delete(ctx context.Context, linkID string) (*Respons
 
 	return s.client.Do(req, nil)
 }
+
+// RemoteLinkIdentifier identifies a remote link that was created or updated.
+type RemoteLinkIdentifier struct {
+	ID   int    `json:"id,omitempty"`
+	Self string `json:"self,omitempty"`
+}
+
+// ListRemoteLinks returns the remote links of an issue, which point to
+// objects outside Jira such as web pages or Confluence pages.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-remote-links/#api-rest-api-3-issue-issueidorkey-remotelink-get
+func (s *IssueLinksService) ListRemoteLinks(ctx context.Context, issueIDOrKey string) ([]*RemoteLink, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/%s/remotelink", issueIDOrKey)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var links []*RemoteLink
+	resp, err := s.client.Do(req, &links)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return links, resp, nil
+}
+
+// GetRemoteLink returns a remote link of an issue by ID.
+func (s *IssueLinksService) GetRemoteLink(ctx context.Context, issueIDOrKey string, linkID int) (*RemoteLink, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/%s/remotelink/%d", issueIDOrKey, linkID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	link := new(RemoteLink)
+	resp, err := s.client.Do(req, link)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return link, resp, nil
+}
+
+// CreateOrUpdateRemoteLink adds a remote link to an issue. If link has a
+// GlobalID and the issue already has a remote link with that global ID, that
+// link is updated instead, so callers can sync links without tracking Jira's
+// link IDs.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-remote-links/#api-rest-api-3-issue-issueidorkey-remotelink-post
+func (s *IssueLinksService) CreateOrUpdateRemoteLink(ctx context.Context, issueIDOrKey string, link *RemoteLink) (*RemoteLinkIdentifier, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/%s/remotelink", issueIDOrKey)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, link)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(RemoteLinkIdentifier)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// DeleteRemoteLink removes a remote link from an issue by ID.
+func (s *IssueLinksService) DeleteRemoteLink(ctx context.Context, issueIDOrKey string, linkID int) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/%s/remotelink/%d", issueIDOrKey, linkID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteRemoteLinkByGlobalID removes the remote link with the given global ID
+// from an issue.
+func (s *IssueLinksService) DeleteRemoteLinkByGlobalID(ctx context.Context, issueIDOrKey, globalID string) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/%s/remotelink?globalId=%s", issueIDOrKey, url.QueryEscape(globalID))
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,138 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIssueLinksService_CreateOrUpdateRemoteLink(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/issue/TEST-1/remotelink" {
+			t.Errorf("request = %v %v, want POST /rest/api/3/issue/TEST-1/remotelink", r.Method, r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		want := `{"globalId":"system=https://ci.example.com&id=42","relationship":"built by",` +
+			`"object":{"url":"https://ci.example.com/builds/42","title":"Build 42","status":{"resolved":true}}}`
+		if got := strings.TrimSpace(string(body)); got != want {
+			t.Errorf("body =\n%s\nwant\n%s", got, want)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":10000,"self":"https://example.atlassian.net/rest/api/3/issue/TEST-1/remotelink/10000"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, _, err := client.IssueLinks.CreateOrUpdateRemoteLink(context.Background(), "TEST-1", &RemoteLink{
+		GlobalID:     "system=https://ci.example.com&id=42",
+		Relationship: "built by",
+		Object: &RemoteLinkObject{
+			URL:    "https://ci.example.com/builds/42",
+			Title:  "Build 42",
+			Status: &RemoteLinkStatus{Resolved: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateOrUpdateRemoteLink() error = %v", err)
+	}
+	if result.ID != 10000 {
+		t.Errorf("ID = %v, want %v", result.ID, 10000)
+	}
+}
+
+func TestIssueLinksService_RemoteLinks(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /rest/api/3/issue/TEST-1/remotelink":
+			w.Write([]byte(`[{"id":10000,"globalId":"g1","object":{"url":"https://example.com"}}]`))
+		case "GET /rest/api/3/issue/TEST-1/remotelink/10000":
+			w.Write([]byte(`{"id":10000,"globalId":"g1"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+	links, _, err := client.IssueLinks.ListRemoteLinks(ctx, "TEST-1")
+	if err != nil || len(links) != 1 || links[0].Object.URL != "https://example.com" {
+		t.Errorf("ListRemoteLinks() = %v, %v", links, err)
+	}
+	link, _, err := client.IssueLinks.GetRemoteLink(ctx, "TEST-1", 10000)
+	if err != nil || link.GlobalID != "g1" {
+		t.Errorf("GetRemoteLink() = %+v, %v", link, err)
+	}
+	if _, err := client.IssueLinks.DeleteRemoteLink(ctx, "TEST-1", 10000); err != nil {
+		t.Errorf("DeleteRemoteLink() error = %v", err)
+	}
+	if _, err := client.IssueLinks.DeleteRemoteLinkByGlobalID(ctx, "TEST-1", "system=ci&id=42"); err != nil {
+		t.Errorf("DeleteRemoteLinkByGlobalID() error = %v", err)
+	}
+
+	want := []string{
+		"GET /rest/api/3/issue/TEST-1/remotelink",
+		"GET /rest/api/3/issue/TEST-1/remotelink/10000",
+		"DELETE /rest/api/3/issue/TEST-1/remotelink/10000",
+		"DELETE /rest/api/3/issue/TEST-1/remotelink?globalId=system%3Dci%26id%3D42",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests =\n%s\nwant\n%s", strings.Join(requests, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestIssueLinksService_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		comment  *CommentRef
+		wantBody string
+	}{
+		{
+			name:     "without comment",
+			wantBody: `{"type":{"name":"Blocks"},"inwardIssue":{"key":"TEST-2"},"outwardIssue":{"key":"TEST-1"}}`,
+		},
+		{
+			name:    "with comment",
+			comment: &CommentRef{Body: "Linked by the release script", Visibility: &Visibility{Type: "group", Value: "jira-developers"}},
+			wantBody: `{"type":{"name":"Blocks"},"inwardIssue":{"key":"TEST-2"},"outwardIssue":{"key":"TEST-1"},` +
+				`"comment":{"body":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"Linked by the release script"}]}]},` +
+				`"visibility":{"type":"group","value":"jira-developers"}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/issueLink" {
+					t.Errorf("request = %v %v, want POST /rest/api/3/issueLink", r.Method, r.URL.Path)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body =\n%s\nwant\n%s", got, tt.wantBody)
+				}
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			req := &IssueLinkCreateRequest{
+				Type:         &IssueLinkTypeRef{Name: "Blocks"},
+				InwardIssue:  &IssueRef{Key: "TEST-2"},
+				OutwardIssue: &IssueRef{Key: "TEST-1"},
+				Comment:      tt.comment,
+			}
+			if _, err := client.IssueLinks.Create(context.Background(), req); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if tt.comment != nil && tt.comment.Body != "Linked by the release script" {
+				t.Error("Create() modified the caller's comment")
+			}
+		})
+	}
+}
@@ -1,11 +1,21 @@
 package jira
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"iter"
+	"maps"
 	"net/http"
 	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // IssuesService handles communication with the issue related methods of the Jira API.
@@ -69,6 +79,189 @@ type IssueFields struct {
 	Unknowns             map[string]any `json:"-"` // Custom fields
 }
 
+// issueFieldsJSON has the fields of IssueFields without its JSON methods.
+type issueFieldsJSON IssueFields
+
+// issueFieldIndex maps the JSON keys of the known IssueFields fields to
+// their field index.
+var issueFieldIndex = func() map[string]int {
+	index := make(map[string]int)
+	t := reflect.TypeOf(IssueFields{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			index[name] = i
+		}
+	}
+	return index
+}()
+
+// UnmarshalJSON decodes the known fields and collects every other key, such
+// as customfield_10020, into Unknowns. Numbers in Unknowns are decoded as
+// json.Number so that they re-encode exactly. The object is split into its
+// keys once and each value decoded once, as issues in search results can
+// carry many large fields.
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		return nil
+	}
+
+	var fields IssueFields
+	known := reflect.ValueOf(&fields).Elem()
+	for key, value := range raw {
+		if i, ok := issueFieldIndex[key]; ok {
+			if err := json.Unmarshal(value, known.Field(i).Addr().Interface()); err != nil {
+				return fmt.Errorf("field %s: %w", key, err)
+			}
+			continue
+		}
+		dec := json.NewDecoder(bytes.NewReader(value))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			return fmt.Errorf("field %s: %w", key, err)
+		}
+		if fields.Unknowns == nil {
+			fields.Unknowns = make(map[string]any)
+		}
+		fields.Unknowns[key] = v
+	}
+
+	*f = fields
+	return nil
+}
+
+// MarshalJSON encodes the known fields and merges Unknowns into the same
+// object. Known fields take precedence over Unknowns entries with the same key.
+func (f IssueFields) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(issueFieldsJSON(f))
+	if err != nil || len(f.Unknowns) == 0 {
+		return data, err
+	}
+
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return nil, err
+	}
+	for key, value := range f.Unknowns {
+		if _, ok := issueFieldIndex[key]; ok {
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", key, err)
+		}
+		merged[key] = raw
+	}
+	return json.Marshal(merged)
+}
+
+// CustomFieldString returns the value of a text custom field. It returns
+// false if the field is absent or not a string.
+func (f *IssueFields) CustomFieldString(id string) (string, bool) {
+	if f == nil {
+		return "", false
+	}
+	v, ok := f.Unknowns[id].(string)
+	return v, ok
+}
+
+// CustomFieldOption returns the value of a single-select or radio button
+// custom field. It returns false if the field is absent or not an option.
+func (f *IssueFields) CustomFieldOption(id string) (*FieldOption, bool) {
+	if f == nil {
+		return nil, false
+	}
+	return fieldOption(f.Unknowns[id])
+}
+
+// CustomFieldOptions returns the values of a multi-select or checkbox custom
+// field. It returns false if the field is absent or any element is not an
+// option.
+func (f *IssueFields) CustomFieldOptions(id string) ([]*FieldOption, bool) {
+	if f == nil {
+		return nil, false
+	}
+	values, ok := f.Unknowns[id].([]any)
+	if !ok {
+		return nil, false
+	}
+	options := make([]*FieldOption, len(values))
+	for i, v := range values {
+		if options[i], ok = fieldOption(v); !ok {
+			return nil, false
+		}
+	}
+	return options, true
+}
+
+// CustomFieldCascade returns the parent and child options of a cascading
+// select custom field. child is nil if only the parent is set. It returns
+// false if the field is absent or not a cascading option.
+func (f *IssueFields) CustomFieldCascade(id string) (parent, child *FieldOption, ok bool) {
+	if f == nil {
+		return nil, nil, false
+	}
+	if parent, ok = fieldOption(f.Unknowns[id]); !ok {
+		return nil, nil, false
+	}
+	if c, present := f.Unknowns[id].(map[string]any)["child"]; present && c != nil {
+		if child, ok = fieldOption(c); !ok {
+			return nil, nil, false
+		}
+	}
+	return parent, child, true
+}
+
+// CustomFieldUser returns the value of a single user picker custom field. It
+// returns false if the field is absent or not a user.
+func (f *IssueFields) CustomFieldUser(id string) (*User, bool) {
+	if f == nil {
+		return nil, false
+	}
+	m, ok := f.Unknowns[id].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	accountID, ok := m["accountId"].(string)
+	if !ok || accountID == "" {
+		return nil, false
+	}
+	user := &User{AccountID: accountID}
+	user.DisplayName, _ = m["displayName"].(string)
+	user.EmailAddress, _ = m["emailAddress"].(string)
+	user.Active, _ = m["active"].(bool)
+	return user, true
+}
+
+// fieldOption converts a decoded {"id": ..., "value": ...} object into a
+// FieldOption. The id may be a string or a number.
+func fieldOption(v any) (*FieldOption, bool) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	value, ok := m["value"].(string)
+	if !ok {
+		return nil, false
+	}
+	option := &FieldOption{Value: value}
+	switch id := m["id"].(type) {
+	case string:
+		option.ID = id
+	case json.Number:
+		option.ID = id.String()
+	case float64:
+		option.ID = strconv.FormatFloat(id, 'f', -1, 64)
+	}
+	option.Disabled, _ = m["disabled"].(bool)
+	return option, true
+}
+
 // SecurityLevel represents an issue security level.
 type SecurityLevel struct {
 	Self        string `json:"self,omitempty"`
@@ -94,6 +287,91 @@ type TimeTracking struct {
 	TimeSpentSeconds         int    `json:"timeSpentSeconds,omitempty"`
 }
 
+// RemainingEstimate returns the issue's remaining estimate, preferring the
+// timeestimate field and falling back to the time tracking block. It returns
+// zero if neither is present.
+func (f *IssueFields) RemainingEstimate() time.Duration {
+	if f == nil {
+		return 0
+	}
+	if f.TimeEstimate > 0 {
+		return time.Duration(f.TimeEstimate) * time.Second
+	}
+	if f.TimeTracking != nil {
+		return time.Duration(f.TimeTracking.RemainingEstimateSeconds) * time.Second
+	}
+	return 0
+}
+
+// TimeSpentDuration returns the time logged on the issue, preferring the
+// timespent field and falling back to the time tracking block. It returns
+// zero if neither is present.
+func (f *IssueFields) TimeSpentDuration() time.Duration {
+	if f == nil {
+		return 0
+	}
+	if f.TimeSpent > 0 {
+		return time.Duration(f.TimeSpent) * time.Second
+	}
+	if f.TimeTracking != nil {
+		return time.Duration(f.TimeTracking.TimeSpentSeconds) * time.Second
+	}
+	return 0
+}
+
+// PercentComplete returns the issue's own progress as a percentage from 0 to
+// 100. It returns 0 when progress is absent or no time is estimated or logged.
+func (f *IssueFields) PercentComplete() int {
+	if f == nil {
+		return 0
+	}
+	return f.Progress.percent()
+}
+
+// AggregatePercentComplete is like PercentComplete but includes sub-tasks.
+func (f *IssueFields) AggregatePercentComplete() int {
+	if f == nil {
+		return 0
+	}
+	return f.AggregateProgress.percent()
+}
+
+// IsToDo reports whether the issue's status is in the To Do category. It is
+// false if the issue was fetched without its status.
+func (i *Issue) IsToDo() bool { return i.statusCategory() == StatusCategoryToDo }
+
+// IsInProgress reports whether the issue's status is in the In Progress
+// category. It is false if the issue was fetched without its status.
+func (i *Issue) IsInProgress() bool { return i.statusCategory() == StatusCategoryInProgress }
+
+// IsDone reports whether the issue's status is in the Done category. It is
+// false if the issue was fetched without its status.
+func (i *Issue) IsDone() bool { return i.statusCategory() == StatusCategoryDone }
+
+// statusCategory returns the key of the issue's status category, or "" if
+// any part of it is missing.
+func (i *Issue) statusCategory() string {
+	if i == nil || i.Fields == nil || i.Fields.Status == nil || i.Fields.Status.StatusCategory == nil {
+		return ""
+	}
+	return i.Fields.Status.StatusCategory.Key
+}
+
+// percent returns the reported percentage, or derives it from the progress
+// and total seconds when Jira omitted it.
+func (p *Progress) percent() int {
+	if p == nil {
+		return 0
+	}
+	if p.Percent > 0 {
+		return min(p.Percent, 100)
+	}
+	if p.Total <= 0 {
+		return 0
+	}
+	return min(p.Progress*100/p.Total, 100)
+}
+
 // Comments represents a list of comments on an issue.
 type Comments struct {
 	StartAt    int        `json:"startAt,omitempty"`
@@ -115,6 +393,7 @@ type Changelog struct {
 	StartAt    int              `json:"startAt,omitempty"`
 	MaxResults int              `json:"maxResults,omitempty"`
 	Total      int              `json:"total,omitempty"`
+	IsLast     bool             `json:"isLast,omitempty"`
 	Histories  []*ChangeHistory `json:"histories,omitempty"`
 }
 
@@ -137,6 +416,91 @@ type ChangeItem struct {
 	ToString   string `json:"toString,omitempty"`
 }
 
+// The following accessors interpret a change item's raw From/To IDs and
+// FromString/ToString display values according to the changed field. Each
+// returns nil if the item is for a different field or the value was empty.
+
+// FromUser returns the previous user of an assignee, reporter or creator change.
+func (c *ChangeItem) FromUser() *User { return c.user(c.From, c.FromString) }
+
+// ToUser returns the new user of an assignee, reporter or creator change.
+func (c *ChangeItem) ToUser() *User { return c.user(c.To, c.ToString) }
+
+func (c *ChangeItem) user(accountID, displayName string) *User {
+	if !c.isSystemField("assignee", "reporter", "creator") || accountID == "" {
+		return nil
+	}
+	return &User{AccountID: accountID, DisplayName: displayName}
+}
+
+// FromStatus returns the previous status of a status change.
+func (c *ChangeItem) FromStatus() *Status { return c.status(c.From, c.FromString) }
+
+// ToStatus returns the new status of a status change.
+func (c *ChangeItem) ToStatus() *Status { return c.status(c.To, c.ToString) }
+
+func (c *ChangeItem) status(id, name string) *Status {
+	if !c.isSystemField("status") || id == "" {
+		return nil
+	}
+	return &Status{ID: id, Name: name}
+}
+
+// FromPriority returns the previous priority of a priority change.
+func (c *ChangeItem) FromPriority() *Priority { return c.priority(c.From, c.FromString) }
+
+// ToPriority returns the new priority of a priority change.
+func (c *ChangeItem) ToPriority() *Priority { return c.priority(c.To, c.ToString) }
+
+func (c *ChangeItem) priority(id, name string) *Priority {
+	if !c.isSystemField("priority") || id == "" {
+		return nil
+	}
+	return &Priority{ID: id, Name: name}
+}
+
+// FromResolution returns the previous resolution of a resolution change.
+func (c *ChangeItem) FromResolution() *Resolution { return c.resolution(c.From, c.FromString) }
+
+// ToResolution returns the new resolution of a resolution change.
+func (c *ChangeItem) ToResolution() *Resolution { return c.resolution(c.To, c.ToString) }
+
+func (c *ChangeItem) resolution(id, name string) *Resolution {
+	if !c.isSystemField("resolution") || id == "" {
+		return nil
+	}
+	return &Resolution{ID: id, Name: name}
+}
+
+// FromLabels returns the labels before a labels change. Jira records labels
+// only in FromString and ToString, separated by spaces.
+func (c *ChangeItem) FromLabels() []string { return c.labels(c.FromString) }
+
+// ToLabels returns the labels after a labels change.
+func (c *ChangeItem) ToLabels() []string { return c.labels(c.ToString) }
+
+func (c *ChangeItem) labels(value string) []string {
+	if !c.isSystemField("labels") {
+		return nil
+	}
+	return strings.Fields(value)
+}
+
+// isSystemField reports whether the item records a change to one of the
+// named system fields. Older changelogs omit FieldID, so Field is checked
+// as well.
+func (c *ChangeItem) isSystemField(ids ...string) bool {
+	if c.FieldType != "" && c.FieldType != "jira" {
+		return false
+	}
+	for _, id := range ids {
+		if c.FieldID == id || (c.FieldID == "" && strings.EqualFold(c.Field, id)) {
+			return true
+		}
+	}
+	return false
+}
+
 // Operations represents available operations on an issue.
 type Operations struct {
 	LinkGroups []*LinkGroup `json:"linkGroups,omitempty"`
@@ -182,10 +546,43 @@ type IssueGetOptions struct {
 	// Whether fields should be returned in the response.
 	FieldsByKeys bool `url:"fieldsByKeys,omitempty"`
 
-	// Whether to update the issue history.
+	// FullDetail expands everything an issue detail view needs in one call:
+	// transitions, operations, editmeta, changelog and renderedFields. It is
+	// combined with any values in Expand.
+	FullDetail bool `url:"-"`
+
+	// IfNoneMatch makes the request conditional on the issue having changed
+	// since the response that carried this ETag (see Response.ETag); if it
+	// hasn't, Get returns ErrNotModified. Jira Cloud does not send ETags for
+	// issues on every site, so callers must cope with an empty Response.ETag
+	// and always receiving the full issue.
+	IfNoneMatch string `url:"-"`
+
+	// IfModifiedSince makes the request conditional on the issue having
+	// changed after this time (see Response.LastModified); if it hasn't, Get
+	// returns ErrNotModified. As with IfNoneMatch, Jira may ignore it and
+	// return the full issue.
+	IfModifiedSince time.Time `url:"-"`
+
+	// Whether to add the issue to the caller's view history, as opening it in
+	// Jira would. Only issues fetched this way show up in GetRecentlyViewed.
 	UpdateHistory bool `url:"updateHistory,omitempty"`
 }
 
+// issueFullDetailExpand is the expand used by IssueGetOptions.FullDetail.
+var issueFullDetailExpand = []string{"transitions", "operations", "editmeta", "changelog", "renderedFields"}
+
+// appendMissing appends the values not already in list, keeping list's order.
+func appendMissing(list []string, values ...string) []string {
+	out := append([]string(nil), list...)
+	for _, v := range values {
+		if !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
 // Get returns a single issue.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-get
@@ -197,8 +594,12 @@ func (s *IssuesService) Get(ctx context.Context, issueIDOrKey string, opts *Issu
 		if len(opts.Fields) > 0 {
 			query.Set("fields", strings.Join(opts.Fields, ","))
 		}
-		if len(opts.Expand) > 0 {
-			query.Set("expand", strings.Join(opts.Expand, ","))
+		expand := opts.Expand
+		if opts.FullDetail {
+			expand = appendMissing(expand, issueFullDetailExpand...)
+		}
+		if len(expand) > 0 {
+			query.Set("expand", strings.Join(expand, ","))
 		}
 		if len(opts.Properties) > 0 {
 			query.Set("properties", strings.Join(opts.Properties, ","))
@@ -218,6 +619,12 @@ func (s *IssuesService) Get(ctx context.Context, issueIDOrKey string, opts *Issu
 	if err != nil {
 		return nil, nil, err
 	}
+	if opts != nil && opts.IfNoneMatch != "" {
+		req.Header.Set("If-None-Match", opts.IfNoneMatch)
+	}
+	if opts != nil && !opts.IfModifiedSince.IsZero() {
+		req.Header.Set("If-Modified-Since", opts.IfModifiedSince.UTC().Format(http.TimeFormat))
+	}
 
 	issue := new(Issue)
 	resp, err := s.client.Do(req, issue)
@@ -228,6 +635,170 @@ func (s *IssuesService) Get(ctx context.Context, issueIDOrKey string, opts *Issu
 	return issue, resp, nil
 }
 
+// GetRecentlyViewed returns the issues the caller viewed most recently, newest
+// first. Jira has no dedicated endpoint for this, so it searches with the
+// issueHistory() JQL function. Views are only recorded for issues opened in
+// the UI or fetched with IssueGetOptions.UpdateHistory set.
+func (s *IssuesService) GetRecentlyViewed(ctx context.Context, opts *SearchOptions) ([]*Issue, *Response, error) {
+	result, resp, err := s.client.Search.Do(ctx, "issue in issueHistory() ORDER BY lastViewed DESC", opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Issues, resp, nil
+}
+
+// BulkFetchRequest represents a request to fetch several issues at once.
+type BulkFetchRequest struct {
+	IssueIDsOrKeys []string `json:"issueIdsOrKeys"`
+	Fields         []string `json:"fields,omitempty"`
+	Expand         []string `json:"expand,omitempty"`
+	Properties     []string `json:"properties,omitempty"`
+	FieldsByKeys   bool     `json:"fieldsByKeys,omitempty"`
+}
+
+// BulkFetchResult represents the response from fetching several issues.
+type BulkFetchResult struct {
+	Issues      []*Issue          `json:"issues,omitempty"`
+	IssueErrors []*BulkIssueError `json:"issueErrors,omitempty"`
+}
+
+// BulkIssueError describes an issue Jira could not return from a bulk fetch
+// because of a retriable error or payload constraint.
+type BulkIssueError struct {
+	ID           string `json:"id,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// Error implements the error interface.
+func (e *BulkIssueError) Error() string {
+	return fmt.Sprintf("issue %s: %s", e.ID, e.ErrorMessage)
+}
+
+// maxBulkFetchIssues is the number of issues the bulk fetch endpoint accepts per request.
+const maxBulkFetchIssues = 100
+
+// BulkFetch returns up to 100 issues in one request. Issues that don't exist
+// or that the user can't view are silently omitted from the result.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-bulkfetch-post
+func (s *IssuesService) BulkFetch(ctx context.Context, fetch *BulkFetchRequest) (*BulkFetchResult, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "/rest/api/3/issue/bulkfetch", fetch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(BulkFetchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// GetMany fetches the issues identified by keys, which may be issue keys or
+// IDs, in batches of 100. It returns the issues indexed by the identifier the
+// caller requested, and the identifiers Jira did not return, in request order.
+//
+// Jira does not distinguish between issues that don't exist and issues the
+// user can't view; both are reported in missing. Issues that Jira failed to
+// return because of a retriable error are not reported as missing; instead
+// they are joined into the returned error as *BulkIssueError values, so
+// callers can retry them. Only Fields, Expand, Properties and FieldsByKeys
+// are used from opts. An identifier given more than once is fetched and
+// reported once.
+func (s *IssuesService) GetMany(ctx context.Context, keys []string, opts *IssueGetOptions) (map[string]*Issue, []string, error) {
+	seen := make(map[string]bool, len(keys))
+	unique := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, key)
+		}
+	}
+	keys = unique
+
+	issues := make(map[string]*Issue, len(keys))
+	failed := make(map[string]bool)
+	var errs []error
+
+	for start := 0; start < len(keys); start += maxBulkFetchIssues {
+		end := min(start+maxBulkFetchIssues, len(keys))
+		batch := keys[start:end]
+
+		fetch := &BulkFetchRequest{IssueIDsOrKeys: batch}
+		if opts != nil {
+			fetch.Fields = opts.Fields
+			fetch.Expand = opts.Expand
+			fetch.Properties = opts.Properties
+			fetch.FieldsByKeys = opts.FieldsByKeys
+		}
+
+		result, _, err := s.BulkFetch(ctx, fetch)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		byIdentifier := make(map[string]*Issue, len(result.Issues)*2)
+		for _, issue := range result.Issues {
+			byIdentifier[strings.ToUpper(issue.Key)] = issue
+			byIdentifier[issue.ID] = issue
+		}
+		for _, issueErr := range result.IssueErrors {
+			failed[strings.ToUpper(issueErr.ID)] = true
+			errs = append(errs, issueErr)
+		}
+		for _, key := range batch {
+			if issue, ok := byIdentifier[strings.ToUpper(key)]; ok {
+				issues[key] = issue
+			}
+		}
+	}
+
+	var missing []string
+	for _, key := range keys {
+		if _, ok := issues[key]; !ok && !failed[strings.ToUpper(key)] {
+			missing = append(missing, key)
+		}
+	}
+
+	return issues, missing, errors.Join(errs...)
+}
+
+// GetWithSubtasks returns an issue with each entry of Fields.Subtasks
+// replaced by the full subtask, fetched in bulk with subtaskFields. An empty
+// subtaskFields returns the subtasks' navigable fields.
+//
+// Subtasks Jira can't return keep their shallow stub. As with GetMany,
+// retriable failures are joined into the returned error alongside the issue.
+func (s *IssuesService) GetWithSubtasks(ctx context.Context, issueIDOrKey string, subtaskFields []string) (*Issue, error) {
+	issue, _, err := s.Get(ctx, issueIDOrKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	if issue.Fields == nil || len(issue.Fields.Subtasks) == 0 {
+		return issue, nil
+	}
+
+	keys := make([]string, len(issue.Fields.Subtasks))
+	for i, subtask := range issue.Fields.Subtasks {
+		keys[i] = subtask.Key
+	}
+
+	subtasks, _, err := s.GetMany(ctx, keys, &IssueGetOptions{Fields: subtaskFields})
+	if subtasks == nil {
+		return nil, err
+	}
+	for i, key := range keys {
+		if subtask, ok := subtasks[key]; ok {
+			issue.Fields.Subtasks[i] = subtask
+		}
+	}
+
+	return issue, err
+}
+
 // IssueCreateRequest represents a request to create an issue.
 type IssueCreateRequest struct {
 	Fields          map[string]any    `json:"fields,omitempty"`
@@ -268,6 +839,50 @@ type HistoryMetadataParticipant struct {
 	URL            string `json:"url,omitempty"`
 }
 
+// NewHistoryMetadata returns history metadata with the given activity description,
+// which Jira shows in the issue history in place of the default change text.
+//
+// The *Key fields are i18n keys that Jira only resolves for Connect and Forge apps,
+// so the builder sets the literal descriptions instead.
+//
+//	meta := jira.NewHistoryMetadata("Synced from CI").
+//		WithActor("ci-bot", "CI Bot", "").
+//		WithExtraData("build", "1234")
+func NewHistoryMetadata(activityDescription string) *HistoryMetadata {
+	return &HistoryMetadata{
+		ActivityDescription: activityDescription,
+	}
+}
+
+// WithActor sets the participant that performed the change.
+func (h *HistoryMetadata) WithActor(id, displayName, actorURL string) *HistoryMetadata {
+	h.Actor = &HistoryMetadataParticipant{
+		ID:          id,
+		DisplayName: displayName,
+		URL:         actorURL,
+	}
+	return h
+}
+
+// WithCause sets the participant or event that caused the change, such as a
+// build or a webhook delivery.
+func (h *HistoryMetadata) WithCause(id, participantType string) *HistoryMetadata {
+	h.Cause = &HistoryMetadataParticipant{
+		ID:   id,
+		Type: participantType,
+	}
+	return h
+}
+
+// WithExtraData adds a key/value pair to the metadata's extra data.
+func (h *HistoryMetadata) WithExtraData(key, value string) *HistoryMetadata {
+	if h.ExtraData == nil {
+		h.ExtraData = make(map[string]string)
+	}
+	h.ExtraData[key] = value
+	return h
+}
+
 // IssueCreateResponse represents the response from creating an issue.
 type IssueCreateResponse struct {
 	ID         string            `json:"id,omitempty"`
@@ -307,7 +922,196 @@ func (s *IssuesService) Create(ctx context.Context, issue *IssueCreateRequest) (
 	return result, resp, nil
 }
 
-// CreateBulk creates multiple issues in one request.
+// WithReporter sets the reporter field of the request to the given account.
+// Creating an issue with a reporter other than the caller requires the
+// Modify Reporter project permission; see IssuesService.CreateWithReporter.
+func (r *IssueCreateRequest) WithReporter(accountID string) *IssueCreateRequest {
+	if r.Fields == nil {
+		r.Fields = make(map[string]any)
+	}
+	r.Fields["reporter"] = map[string]any{"accountId": accountID}
+	return r
+}
+
+// IssueFieldsBuilder builds the fields map of an IssueCreateRequest, filling
+// in the nested objects Jira expects for each system field.
+//
+//	req := &jira.IssueCreateRequest{
+//		Fields: jira.NewIssueFieldsBuilder().
+//			Project("PROJ").
+//			IssueType("Bug").
+//			Summary("Login fails").
+//			Labels("auth", "regression").
+//			Build(),
+//	}
+type IssueFieldsBuilder struct {
+	fields map[string]any
+}
+
+// NewIssueFieldsBuilder returns an empty IssueFieldsBuilder.
+func NewIssueFieldsBuilder() *IssueFieldsBuilder {
+	return &IssueFieldsBuilder{fields: make(map[string]any)}
+}
+
+// Summary sets the issue summary.
+func (b *IssueFieldsBuilder) Summary(summary string) *IssueFieldsBuilder {
+	b.fields["summary"] = summary
+	return b
+}
+
+// Description sets the issue description, which must be an ADF document.
+func (b *IssueFieldsBuilder) Description(doc any) *IssueFieldsBuilder {
+	b.fields["description"] = doc
+	return b
+}
+
+// Project sets the project by key.
+func (b *IssueFieldsBuilder) Project(key string) *IssueFieldsBuilder {
+	b.fields["project"] = map[string]any{"key": key}
+	return b
+}
+
+// ProjectID sets the project by ID.
+func (b *IssueFieldsBuilder) ProjectID(id string) *IssueFieldsBuilder {
+	b.fields["project"] = map[string]any{"id": id}
+	return b
+}
+
+// IssueType sets the issue type by name.
+func (b *IssueFieldsBuilder) IssueType(name string) *IssueFieldsBuilder {
+	b.fields["issuetype"] = map[string]any{"name": name}
+	return b
+}
+
+// IssueTypeID sets the issue type by ID.
+func (b *IssueFieldsBuilder) IssueTypeID(id string) *IssueFieldsBuilder {
+	b.fields["issuetype"] = map[string]any{"id": id}
+	return b
+}
+
+// Parent sets the parent issue by key, for subtasks and child issues.
+func (b *IssueFieldsBuilder) Parent(key string) *IssueFieldsBuilder {
+	b.fields["parent"] = map[string]any{"key": key}
+	return b
+}
+
+// Assignee sets the assignee by account ID.
+func (b *IssueFieldsBuilder) Assignee(accountID string) *IssueFieldsBuilder {
+	b.fields["assignee"] = map[string]any{"accountId": accountID}
+	return b
+}
+
+// Reporter sets the reporter by account ID. See IssuesService.CreateWithReporter
+// for the permission this needs.
+func (b *IssueFieldsBuilder) Reporter(accountID string) *IssueFieldsBuilder {
+	b.fields["reporter"] = map[string]any{"accountId": accountID}
+	return b
+}
+
+// Priority sets the priority by name.
+func (b *IssueFieldsBuilder) Priority(name string) *IssueFieldsBuilder {
+	b.fields["priority"] = map[string]any{"name": name}
+	return b
+}
+
+// Labels sets the issue labels.
+func (b *IssueFieldsBuilder) Labels(labels ...string) *IssueFieldsBuilder {
+	b.fields["labels"] = labels
+	return b
+}
+
+// Components sets the issue components by name.
+func (b *IssueFieldsBuilder) Components(names ...string) *IssueFieldsBuilder {
+	components := make([]map[string]any, len(names))
+	for i, name := range names {
+		components[i] = map[string]any{"name": name}
+	}
+	b.fields["components"] = components
+	return b
+}
+
+// Custom sets a field by ID, such as "customfield_10010", to value as is.
+func (b *IssueFieldsBuilder) Custom(fieldID string, value any) *IssueFieldsBuilder {
+	b.fields[fieldID] = value
+	return b
+}
+
+// Build returns the fields map for IssueCreateRequest.Fields.
+func (b *IssueFieldsBuilder) Build() map[string]any {
+	return b.fields
+}
+
+// PermissionError is returned when a pre-check finds that the current user
+// lacks a permission an operation needs.
+type PermissionError struct {
+	Permission string
+	Project    string
+}
+
+// Error implements the error interface.
+func (e *PermissionError) Error() string {
+	return fmt.Sprintf("missing %s permission in project %s", e.Permission, e.Project)
+}
+
+// CreateWithReporter creates an issue reported by reporterAccountID, after
+// checking that the current user holds the MODIFY_REPORTER permission in the
+// issue's project. Without that permission Jira rejects the reporter field
+// with an error that doesn't name the cause, so CreateWithReporter returns a
+// *PermissionError instead and sends no create request.
+//
+// The project is read from issue.Fields["project"], which must be a
+// map[string]any or map[string]string with a "key" or "id" entry. issue is
+// not modified.
+func (s *IssuesService) CreateWithReporter(ctx context.Context, issue *IssueCreateRequest, reporterAccountID string) (*IssueCreateResponse, *Response, error) {
+	if issue == nil {
+		return nil, nil, errors.New("issue create request is nil")
+	}
+	opts := &MyPermissionsOptions{Permissions: "MODIFY_REPORTER"}
+	if key := projectValue(issue.Fields["project"], "key"); key != "" {
+		opts.ProjectKey = key
+	} else if id := projectValue(issue.Fields["project"], "id"); id != "" {
+		opts.ProjectID = id
+	} else {
+		return nil, nil, errors.New("issue fields must identify the project by key or id")
+	}
+
+	perms, resp, err := s.client.Permissions.GetMyPermissions(ctx, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	if p := perms.Permissions["MODIFY_REPORTER"]; p == nil || !p.HavePermission {
+		return nil, resp, &PermissionError{
+			Permission: "MODIFY_REPORTER",
+			Project:    opts.ProjectKey + opts.ProjectID,
+		}
+	}
+
+	// Send a copy so the caller's request and fields map are left unchanged.
+	withReporter := *issue
+	withReporter.Fields = make(map[string]any, len(issue.Fields)+1)
+	for k, v := range issue.Fields {
+		withReporter.Fields[k] = v
+	}
+	return s.Create(ctx, withReporter.WithReporter(reporterAccountID))
+}
+
+// projectValue returns the string entry name of a project field value,
+// which may be a map[string]any, as decoded from JSON or set by
+// IssueFieldsBuilder, or a map[string]string.
+func projectValue(project any, name string) string {
+	switch p := project.(type) {
+	case map[string]any:
+		v, _ := p[name].(string)
+		return v
+	case map[string]string:
+		return p[name]
+	}
+	return ""
+}
+
+// CreateBulk creates multiple issues in one request. A request whose body is
+// larger than the client's limit fails with ErrRequestTooLarge without being
+// sent; split the issues into smaller batches.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-bulk-post
 func (s *IssuesService) CreateBulk(ctx context.Context, issues []*IssueCreateRequest) (*IssuesBulkResponse, *Response, error) {
@@ -389,11 +1193,23 @@ func (s *IssuesService) Update(ctx context.Context, issueIDOrKey string, issue *
 
 // IssueUpdateOptions specifies optional parameters for Update.
 type IssueUpdateOptions struct {
-	NotifyUsers            *bool    `url:"notifyUsers,omitempty"`
-	OverrideScreenSecurity bool     `url:"overrideScreenSecurity,omitempty"`
-	OverrideEditableFlag   bool     `url:"overrideEditableFlag,omitempty"`
-	ReturnIssue            bool     `url:"returnIssue,omitempty"`
-	Expand                 []string `url:"expand,omitempty"`
+	// NotifyUsers whether watchers are emailed about the update. Disabling
+	// notifications requires the Administer Jira or Administer project permission.
+	NotifyUsers *bool `url:"notifyUsers,omitempty"`
+
+	// OverrideScreenSecurity allows hidden fields to be edited.
+	// Requires admin; see the package documentation.
+	OverrideScreenSecurity bool `url:"overrideScreenSecurity,omitempty"`
+
+	// OverrideEditableFlag allows non-editable fields to be edited.
+	// Requires admin; see the package documentation.
+	OverrideEditableFlag bool `url:"overrideEditableFlag,omitempty"`
+
+	// ReturnIssue whether the updated issue is returned.
+	ReturnIssue bool `url:"returnIssue,omitempty"`
+
+	// Expand additional information in the returned issue.
+	Expand []string `url:"expand,omitempty"`
 }
 
 // Delete deletes an issue.
@@ -413,17 +1229,34 @@ func (s *IssuesService) Delete(ctx context.Context, issueIDOrKey string, deleteS
 	return s.client.Do(req, nil)
 }
 
-// Assign assigns an issue to a user.
+// Assign assigns an issue to a user, identified by account ID or, if the
+// client uses UserIdentifierUsername, by username. An empty accountID
+// unassigns the issue, as Unassign does.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-assignee-put
 func (s *IssuesService) Assign(ctx context.Context, issueIDOrKey, accountID string) (*Response, error) {
-	u := fmt.Sprintf("/rest/api/3/issue/%s/assignee", issueIDOrKey)
-
-	body := map[string]any{}
-	if accountID != "" {
-		body["accountId"] = accountID
+	if accountID == "" {
+		return s.setAssignee(ctx, issueIDOrKey, nil)
 	}
-	// Empty body unassigns
+	return s.setAssignee(ctx, issueIDOrKey, accountID)
+}
+
+// AssignAutomatic assigns an issue to its project's default assignee, or its
+// component lead, as Jira's "Automatic" assignee option does.
+func (s *IssuesService) AssignAutomatic(ctx context.Context, issueIDOrKey string) (*Response, error) {
+	return s.setAssignee(ctx, issueIDOrKey, "-1")
+}
+
+// Unassign removes an issue's assignee.
+func (s *IssuesService) Unassign(ctx context.Context, issueIDOrKey string) (*Response, error) {
+	return s.setAssignee(ctx, issueIDOrKey, nil)
+}
+
+// setAssignee sets an issue's assignee to user, which is nil to unassign it.
+func (s *IssuesService) setAssignee(ctx context.Context, issueIDOrKey string, user any) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/%s/assignee", issueIDOrKey)
+
+	body := map[string]any{s.client.userBodyKey(): user}
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, u, body)
 	if err != nil {
@@ -433,6 +1266,263 @@ func (s *IssuesService) Assign(ctx context.Context, issueIDOrKey, accountID stri
 	return s.client.Do(req, nil)
 }
 
+// ListPropertyKeys returns the keys of an issue's properties.
+func (s *IssuesService) ListPropertyKeys(ctx context.Context, issueIDOrKey string) ([]string, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/%s/properties", issueIDOrKey)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Keys []struct {
+			Key string `json:"key"`
+		} `json:"keys"`
+	}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	keys := make([]string, len(result.Keys))
+	for i, k := range result.Keys {
+		keys[i] = k.Key
+	}
+
+	return keys, resp, nil
+}
+
+// GetPropertyKeys returns the keys of an issue's properties.
+//
+// Deprecated: Use ListPropertyKeys, which matches ProjectsService.
+func (s *IssuesService) GetPropertyKeys(ctx context.Context, issueIDOrKey string) ([]string, *Response, error) {
+	return s.ListPropertyKeys(ctx, issueIDOrKey)
+}
+
+// GetProperty returns an issue property.
+func (s *IssuesService) GetProperty(ctx context.Context, issueIDOrKey, propertyKey string) (*EntityProperty, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/%s/properties/%s", issueIDOrKey, url.PathEscape(propertyKey))
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prop := new(EntityProperty)
+	resp, err := s.client.Do(req, prop)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return prop, resp, nil
+}
+
+// SetProperty sets an issue property.
+func (s *IssuesService) SetProperty(ctx context.Context, issueIDOrKey, propertyKey string, value interface{}) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/%s/properties/%s", issueIDOrKey, url.PathEscape(propertyKey))
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteProperty deletes an issue property.
+func (s *IssuesService) DeleteProperty(ctx context.Context, issueIDOrKey, propertyKey string) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/%s/properties/%s", issueIDOrKey, url.PathEscape(propertyKey))
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// AssignByDisplayName assigns an issue to the assignable user whose display
+// name is displayName, ignoring case. It returns an error without assigning
+// if no assignable user or more than one has that name.
+func (s *IssuesService) AssignByDisplayName(ctx context.Context, issueKey, displayName string) (*Response, error) {
+	users, resp, err := s.client.Users.FindAssignableUsers(ctx, &FindAssignableOptions{
+		Query:    displayName,
+		IssueKey: issueKey,
+	})
+	if err != nil {
+		return resp, err
+	}
+
+	var matches []*User
+	for _, u := range users {
+		if strings.EqualFold(u.DisplayName, displayName) {
+			matches = append(matches, u)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return resp, fmt.Errorf("no assignable user named %q for issue %s", displayName, issueKey)
+	case 1:
+		return s.assignUser(ctx, issueKey, matches[0])
+	default:
+		ids := make([]string, len(matches))
+		for i, u := range matches {
+			ids[i] = u.AccountID
+		}
+		return resp, fmt.Errorf("%d assignable users named %q for issue %s: %s", len(matches), displayName, issueKey, strings.Join(ids, ", "))
+	}
+}
+
+// AssignByQuery assigns an issue to the assignable user matching query, which
+// Jira compares with display names and email addresses, so a partial name or
+// an email works. If Jira finds several users, the one whose display name or
+// email address equals query, ignoring case, is chosen. It returns an error
+// without assigning if no user matches or the match is ambiguous.
+func (s *IssuesService) AssignByQuery(ctx context.Context, issueIDOrKey, query string) (*Response, error) {
+	users, resp, err := s.client.Users.FindAssignableUsers(ctx, &FindAssignableOptions{
+		Query:    query,
+		IssueKey: issueIDOrKey,
+	})
+	if err != nil {
+		return resp, err
+	}
+
+	switch len(users) {
+	case 0:
+		return resp, fmt.Errorf("no assignable user matches %q for issue %s", query, issueIDOrKey)
+	case 1:
+		return s.assignUser(ctx, issueIDOrKey, users[0])
+	}
+
+	var exact []*User
+	for _, u := range users {
+		if strings.EqualFold(u.DisplayName, query) || strings.EqualFold(u.EmailAddress, query) {
+			exact = append(exact, u)
+		}
+	}
+	if len(exact) == 1 {
+		return s.assignUser(ctx, issueIDOrKey, exact[0])
+	}
+	if len(exact) > 1 {
+		users = exact
+	}
+
+	names := make([]string, len(users))
+	for i, u := range users {
+		names[i] = fmt.Sprintf("%s (%s)", u.DisplayName, s.client.userID(u))
+	}
+	return resp, fmt.Errorf("%d assignable users match %q for issue %s: %s", len(users), query, issueIDOrKey, strings.Join(names, ", "))
+}
+
+// assignUser assigns an issue to u, identified as the client's user
+// identifier mode requires. It returns an error rather than unassigning the
+// issue if u lacks that identifier.
+func (s *IssuesService) assignUser(ctx context.Context, issueIDOrKey string, u *User) (*Response, error) {
+	id := s.client.userID(u)
+	if id == "" {
+		return nil, fmt.Errorf("user %q has no %s to assign issue %s by", u.DisplayName, s.client.userBodyKey(), issueIDOrKey)
+	}
+	return s.Assign(ctx, issueIDOrKey, id)
+}
+
+// AssignByJQL assigns every issue matching jql to accountID, using up to
+// concurrency parallel requests. An empty accountID unassigns the issues.
+//
+// Matches are streamed page by page, so assignment starts before the search
+// completes. It returns the number of issues assigned and one error per failed
+// issue; a failed search or a cancelled context stops the operation and is
+// reported as the last error.
+func (s *IssuesService) AssignByJQL(ctx context.Context, jql, accountID string, concurrency int) (int, []error) {
+	return s.forEachByJQL(ctx, jql, concurrency, "assign", func(key string) error {
+		_, err := s.Assign(ctx, key, accountID)
+		return err
+	})
+}
+
+// SetPropertyByJQL sets the property propertyKey to value on every issue
+// matching jql, using up to concurrency parallel requests. It streams matches
+// and reports results the same way as AssignByJQL.
+func (s *IssuesService) SetPropertyByJQL(ctx context.Context, jql, propertyKey string, value any, concurrency int) (int, []error) {
+	return s.forEachByJQL(ctx, jql, concurrency, "set property on", func(key string) error {
+		_, err := s.SetProperty(ctx, key, propertyKey, value)
+		return err
+	})
+}
+
+// DeletePropertyByJQL deletes the property propertyKey from every issue
+// matching jql, using up to concurrency parallel requests. It streams matches
+// and reports results the same way as AssignByJQL.
+func (s *IssuesService) DeletePropertyByJQL(ctx context.Context, jql, propertyKey string, concurrency int) (int, []error) {
+	return s.forEachByJQL(ctx, jql, concurrency, "delete property from", func(key string) error {
+		_, err := s.DeleteProperty(ctx, key, propertyKey)
+		return err
+	})
+}
+
+// forEachByJQL calls fn with the key of every issue matching jql, using up to
+// concurrency goroutines within the client's WithMaxConcurrency limit. It
+// returns the number of successful calls and one error per failed call,
+// prefixed with op and the issue key; a failed search or a cancelled context
+// is reported as the last error.
+func (s *IssuesService) forEachByJQL(ctx context.Context, jql string, concurrency int, op string, fn func(key string) error) (int, []error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		count int
+		errs  []error
+	)
+
+	keys := make(chan string)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keys {
+				err := s.client.acquireBatchSlot(ctx)
+				if err == nil {
+					err = fn(key)
+					s.client.releaseBatchSlot()
+				}
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s %s: %w", op, key, err))
+				} else {
+					count++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	var searchErr error
+	opts := &SearchOptions{Fields: []string{"key"}, MaxResults: 100}
+	for issue, err := range s.client.Search.Stream(ctx, jql, opts) {
+		if err == nil {
+			select {
+			case keys <- issue.Key:
+				continue
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+		}
+		searchErr = err
+		break
+	}
+
+	close(keys)
+	wg.Wait()
+
+	if searchErr != nil {
+		errs = append(errs, searchErr)
+	}
+	return count, errs
+}
+
 // GetTransitions returns the available transitions for an issue.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-transitions-get
@@ -500,6 +1590,75 @@ func (s *IssuesService) DoTransition(ctx context.Context, issueIDOrKey string, t
 	return s.client.Do(req, nil)
 }
 
+// TransitionToStatus moves an issue to the status named statusName by finding
+// the available transition whose target status matches (case-insensitively)
+// and performing it with the given fields, which may be nil.
+//
+// It returns an error if no transition, or more than one, leads to the status.
+func (s *IssuesService) TransitionToStatus(ctx context.Context, issueKey, statusName string, fields map[string]any) error {
+	transitions, _, err := s.GetTransitions(ctx, issueKey, nil)
+	if err != nil {
+		return err
+	}
+
+	var matches []*Transition
+	for _, t := range transitions {
+		if t.To != nil && strings.EqualFold(t.To.Name, statusName) {
+			matches = append(matches, t)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return fmt.Errorf("no transition to status %q available for issue %s", statusName, issueKey)
+	case 1:
+	default:
+		names := make([]string, len(matches))
+		for i, t := range matches {
+			names[i] = t.Name
+		}
+		return fmt.Errorf("multiple transitions to status %q available for issue %s: %s",
+			statusName, issueKey, strings.Join(names, ", "))
+	}
+
+	_, err = s.DoTransition(ctx, issueKey, &IssueTransitionRequest{
+		Transition: &TransitionInput{ID: matches[0].ID},
+		Fields:     fields,
+	})
+	return err
+}
+
+// TransitionByName performs the available transition named transitionName
+// (matched case-insensitively), such as "Start Progress", with the given
+// fields, which may be nil. Use TransitionToStatus to pick a transition by its
+// target status instead.
+//
+// It returns an error listing the available transitions if none has the name.
+func (s *IssuesService) TransitionByName(ctx context.Context, issueIDOrKey, transitionName string, fields map[string]any) (*Response, error) {
+	transitions, resp, err := s.GetTransitions(ctx, issueIDOrKey, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	var match *Transition
+	names := make([]string, len(transitions))
+	for i, t := range transitions {
+		names[i] = t.Name
+		if match == nil && strings.EqualFold(t.Name, transitionName) {
+			match = t
+		}
+	}
+	if match == nil {
+		return resp, fmt.Errorf("transition %q not available for issue %s; available: %s",
+			transitionName, issueIDOrKey, strings.Join(names, ", "))
+	}
+
+	return s.DoTransition(ctx, issueIDOrKey, &IssueTransitionRequest{
+		Transition: &TransitionInput{ID: match.ID},
+		Fields:     fields,
+	})
+}
+
 // IssueTransitionRequest represents a request to transition an issue.
 type IssueTransitionRequest struct {
 	Transition      *TransitionInput  `json:"transition,omitempty"`
@@ -548,12 +1707,124 @@ type ChangelogOptions struct {
 	MaxResults int `url:"maxResults,omitempty"`
 }
 
-// Notify sends a notification about an issue.
+// changelogPageSize is the page size GetAllChangelog and StreamChangelog
+// request; Jira caps it at 100.
+const changelogPageSize = 100
+
+// GetAllChangelog returns every change history of an issue, oldest first,
+// fetching as many GetChangelog pages as needed. The returned Response is
+// that of the last page fetched. On error it returns the histories read so
+// far.
+func (s *IssuesService) GetAllChangelog(ctx context.Context, issueIDOrKey string) ([]*ChangeHistory, *Response, error) {
+	var histories []*ChangeHistory
+	var last *Response
+	for history, err := range s.streamChangelog(ctx, issueIDOrKey, &last) {
+		if err != nil {
+			return histories, last, err
+		}
+		histories = append(histories, history)
+	}
+	return histories, last, nil
+}
+
+// StreamChangelog returns an iterator over every change history of an issue,
+// oldest first, fetching GetChangelog pages as the loop advances. Iteration
+// stops after the first error, which is yielded with a nil history.
+func (s *IssuesService) StreamChangelog(ctx context.Context, issueIDOrKey string) iter.Seq2[*ChangeHistory, error] {
+	return s.streamChangelog(ctx, issueIDOrKey, nil)
+}
+
+// streamChangelog is StreamChangelog, also storing the Response of each page
+// in *last if last is not nil.
+func (s *IssuesService) streamChangelog(ctx context.Context, issueIDOrKey string, last **Response) iter.Seq2[*ChangeHistory, error] {
+	return Paginate(ctx, func(startAt int) ([]*ChangeHistory, *Response, error) {
+		page, resp, err := s.GetChangelog(ctx, issueIDOrKey, &ChangelogOptions{StartAt: startAt, MaxResults: changelogPageSize})
+		if last != nil {
+			*last = resp
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		return page.Histories, resp, nil
+	})
+}
+
+// ChangelogBulkFetchRequest represents a request to BulkFetchChangelogs.
+type ChangelogBulkFetchRequest struct {
+	IssueIDsOrKeys []string `json:"issueIdsOrKeys"`
+	FieldIDs       []string `json:"fieldIds,omitempty"` // Only return changes to these fields
+	MaxResults     int      `json:"maxResults,omitempty"`
+
+	// NextPageToken requests the page after the one that returned it in
+	// ChangelogBulkFetchResult.NextPageToken. Leave it empty for the first
+	// page.
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// ChangelogBulkFetchResult represents a page of BulkFetchChangelogs results.
+// The histories of one issue may continue on the next page.
+type ChangelogBulkFetchResult struct {
+	IssueChangelogs []*IssueChangelog `json:"issueChangeLogs,omitempty"`
+	NextPageToken   string            `json:"nextPageToken,omitempty"`
+}
+
+// IssueChangelog holds change histories of a single issue.
+type IssueChangelog struct {
+	IssueID         string           `json:"issueId,omitempty"`
+	ChangeHistories []*ChangeHistory `json:"changeHistories,omitempty"`
+}
+
+// BulkFetchChangelogs returns a page of the changelogs of up to 1000 issues
+// in one request. Pass the result's NextPageToken back in the request to
+// fetch the next page; it is empty on the last page.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-changelog-bulkfetch-post
+func (s *IssuesService) BulkFetchChangelogs(ctx context.Context, request *ChangelogBulkFetchRequest) (*ChangelogBulkFetchResult, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "/rest/api/3/changelog/bulkfetch", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(ChangelogBulkFetchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// Notify sends a notification about an issue. If notification has a Body,
+// it is sent as HTMLBody, rendered with ADFToHTML, and as TextBody, rendered
+// with ADFToText, for whichever of the two is empty. Notify returns an error
+// without sending anything if notification names no recipients.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-notify-post
 func (s *IssuesService) Notify(ctx context.Context, issueIDOrKey string, notification *Notification) (*Response, error) {
 	u := fmt.Sprintf("/rest/api/3/issue/%s/notify", issueIDOrKey)
 
+	if notification == nil || notification.To.isEmpty() {
+		return nil, errors.New("notification must have at least one recipient")
+	}
+	if notification.Body != nil && (notification.TextBody == "" || notification.HTMLBody == "") {
+		n := *notification
+		if n.TextBody == "" {
+			text, err := ADFToText(n.Body)
+			if err != nil {
+				return nil, fmt.Errorf("notification body: %w", err)
+			}
+			n.TextBody = text
+		}
+		if n.HTMLBody == "" {
+			html, err := ADFToHTML(n.Body)
+			if err != nil {
+				return nil, fmt.Errorf("notification body: %w", err)
+			}
+			n.HTMLBody = html
+		}
+		notification = &n
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, notification)
 	if err != nil {
 		return nil, err
@@ -569,6 +1840,12 @@ type Notification struct {
 	HTMLBody string                  `json:"htmlBody,omitempty"`
 	To       *NotificationRecipients `json:"to,omitempty"`
 	Restrict *NotificationRestrict   `json:"restrict,omitempty"`
+
+	// Body is a rich text body, such as an *adf.Node built with
+	// adf.NewDocument. The notify endpoint takes only text and HTML bodies,
+	// so Notify renders it into whichever of HTMLBody and TextBody is
+	// empty; the HTML keeps its formatting.
+	Body any `json:"-"`
 }
 
 // NotificationRecipients represents the recipients of a notification.
@@ -581,6 +1858,11 @@ type NotificationRecipients struct {
 	Groups   []*Group `json:"groups,omitempty"`
 }
 
+// isEmpty reports whether r names no recipients.
+func (r *NotificationRecipients) isEmpty() bool {
+	return r == nil || !(r.Reporter || r.Assignee || r.Watchers || r.Voters || len(r.Users) > 0 || len(r.Groups) > 0)
+}
+
 // NotificationRestrict represents restrictions on notifications.
 type NotificationRestrict struct {
 	Groups      []*Group                `json:"groups,omitempty"`
@@ -628,11 +1910,68 @@ func (s *IssuesService) GetEditMeta(ctx context.Context, issueIDOrKey string, op
 
 // EditMetaOptions specifies optional parameters for GetEditMeta.
 type EditMetaOptions struct {
+	// OverrideScreenSecurity returns hidden fields.
+	// Requires admin; see the package documentation.
 	OverrideScreenSecurity bool `url:"overrideScreenSecurity,omitempty"`
-	OverrideEditableFlag   bool `url:"overrideEditableFlag,omitempty"`
+
+	// OverrideEditableFlag returns non-editable fields.
+	// Requires admin; see the package documentation.
+	OverrideEditableFlag bool `url:"overrideEditableFlag,omitempty"`
+}
+
+// ValidateUpdate checks fields, as they would be passed in
+// IssueUpdateRequest.Fields, against the issue's edit metadata, and returns
+// a description of each problem found: a field that is not on the issue's
+// edit screen, one that cannot be set, or a required field being cleared.
+// No problems means Jira should accept the fields, although it may still
+// reject their values. The error is for failing to fetch the metadata.
+func (s *IssuesService) ValidateUpdate(ctx context.Context, issueIDOrKey string, fields map[string]any) ([]string, error) {
+	meta, _, err := s.GetEditMeta(ctx, issueIDOrKey, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	for _, key := range slices.Sorted(maps.Keys(fields)) {
+		fm, ok := meta.Fields[key]
+		if !ok || fm == nil {
+			problems = append(problems, fmt.Sprintf("field %q is not on the edit screen of %s", key, issueIDOrKey))
+			continue
+		}
+
+		name := key
+		if fm.Name != "" {
+			name = fmt.Sprintf("%s (%s)", fm.Name, key)
+		}
+		if len(fm.Operations) > 0 && !slices.Contains(fm.Operations, "set") {
+			problems = append(problems, fmt.Sprintf("field %s cannot be set, only: %s", name, strings.Join(fm.Operations, ", ")))
+		}
+		if fm.Required && isEmptyFieldValue(fields[key]) {
+			problems = append(problems, fmt.Sprintf("field %s is required and cannot be cleared", name))
+		}
+	}
+
+	return problems, nil
+}
+
+// isEmptyFieldValue reports whether v would clear a field: nil, an empty
+// string, or an empty list.
+func isEmptyFieldValue(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case []string:
+		return len(v) == 0
+	}
+	return false
 }
 
-// GetCreateMeta returns metadata for creating issues.
+// GetCreateMeta returns metadata for creating issues. Jira is retiring the
+// endpoint behind it; prefer GetCreateMetaIssueTypes and GetCreateMetaFields.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-createmeta-get
 func (s *IssuesService) GetCreateMeta(ctx context.Context, opts *CreateMetaOptions) (*CreateMeta, *Response, error) {
@@ -711,6 +2050,135 @@ type CreateMetaIssueType struct {
 	Fields      map[string]*FieldMeta `json:"fields,omitempty"`
 }
 
+// CreateMetaIssueTypeListResult represents a page of the issue types that
+// can be created in a project.
+type CreateMetaIssueTypeListResult struct {
+	StartAt    int                    `json:"startAt,omitempty"`
+	MaxResults int                    `json:"maxResults,omitempty"`
+	Total      int                    `json:"total,omitempty"`
+	IssueTypes []*CreateMetaIssueType `json:"issueTypes,omitempty"`
+}
+
+// GetCreateMetaIssueTypes returns a page of the issue types that can be
+// created in a project. Pass startAt and maxResults of zero for the server
+// defaults.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-createmeta-projectidorkey-issuetypes-get
+func (s *IssuesService) GetCreateMetaIssueTypes(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (*CreateMetaIssueTypeListResult, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/createmeta/%s/issuetypes", projectKeyOrID)
+
+	params := url.Values{}
+	if startAt > 0 {
+		params.Set("startAt", strconv.Itoa(startAt))
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", strconv.Itoa(maxResults))
+	}
+	if len(params) > 0 {
+		u = fmt.Sprintf("%s?%s", u, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(CreateMetaIssueTypeListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// CreateMetaFieldListResult represents a page of the fields for creating an
+// issue of one type in a project.
+type CreateMetaFieldListResult struct {
+	StartAt    int          `json:"startAt,omitempty"`
+	MaxResults int          `json:"maxResults,omitempty"`
+	Total      int          `json:"total,omitempty"`
+	Fields     []*FieldMeta `json:"fields,omitempty"`
+}
+
+// Map returns the page's fields keyed by field ID, as in
+// CreateMetaIssueType.Fields.
+func (r *CreateMetaFieldListResult) Map() map[string]*FieldMeta {
+	fields := make(map[string]*FieldMeta, len(r.Fields))
+	for _, f := range r.Fields {
+		fields[f.FieldID] = f
+	}
+	return fields
+}
+
+// GetCreateMetaFields returns a page of the fields for creating an issue of
+// the given type in a project. Pass startAt and maxResults of zero for the
+// server defaults.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-createmeta-projectidorkey-issuetypes-issuetypeid-get
+func (s *IssuesService) GetCreateMetaFields(ctx context.Context, projectKeyOrID, issueTypeID string, startAt, maxResults int) (*CreateMetaFieldListResult, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/issue/createmeta/%s/issuetypes/%s", projectKeyOrID, issueTypeID)
+
+	params := url.Values{}
+	if startAt > 0 {
+		params.Set("startAt", strconv.Itoa(startAt))
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", strconv.Itoa(maxResults))
+	}
+	if len(params) > 0 {
+		u = fmt.Sprintf("%s?%s", u, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(CreateMetaFieldListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// CreateMetaDefaults returns a fields map for creating an issue of the given
+// type in the given project, prefilled with the default value of every field
+// that has one. Callers can merge their own values into the map and pass it as
+// IssueCreateRequest.Fields.
+func (s *IssuesService) CreateMetaDefaults(ctx context.Context, projectKey, issueTypeName string) (map[string]any, error) {
+	meta, _, err := s.GetCreateMeta(ctx, &CreateMetaOptions{
+		ProjectKeys:    []string{projectKey},
+		IssueTypeNames: []string{issueTypeName},
+		Expand:         []string{"projects.issuetypes.fields"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range meta.Projects {
+		if !strings.EqualFold(project.Key, projectKey) {
+			continue
+		}
+		for _, issueType := range project.IssueTypes {
+			if !strings.EqualFold(issueType.Name, issueTypeName) {
+				continue
+			}
+			fields := make(map[string]any)
+			for id, field := range issueType.Fields {
+				if field.HasDefaultValue && field.DefaultValue != nil {
+					fields[id] = field.DefaultValue
+				}
+			}
+			return fields, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no create metadata for issue type %q in project %q", issueTypeName, projectKey)
+}
+
 // Archive archives issues.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-archive-put
@@ -742,3 +2210,197 @@ func (s *IssuesService) Unarchive(ctx context.Context, issueIDsOrKeys []string)
 
 	return s.client.Do(req, nil)
 }
+
+// ArchivedExportRequest selects the archived issues ExportArchived exports.
+// Empty fields do not filter.
+type ArchivedExportRequest struct {
+	ArchivedBy        []string           `json:"archivedBy,omitempty"` // Account IDs
+	ArchivedDateRange *ArchivedDateRange `json:"archivedDateRange,omitempty"`
+	IssueTypes        []string           `json:"issueTypes,omitempty"` // Issue type IDs
+	Projects          []string           `json:"projects,omitempty"`   // Project keys
+	Reporters         []string           `json:"reporters,omitempty"`  // Account IDs
+}
+
+// ArchivedDateRange bounds when issues were archived. Dates are in yyyy-MM-dd
+// format.
+type ArchivedDateRange struct {
+	DateAfter  string `json:"dateAfter,omitempty"`
+	DateBefore string `json:"dateBefore,omitempty"`
+}
+
+// ExportArchived starts an export of the archived issues matching request to
+// a CSV file and returns the ID of the task doing the work. Wait for it with
+// Client.Tasks.Wait.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issues-archive-export-put
+func (s *IssuesService) ExportArchived(ctx context.Context, request *ArchivedExportRequest) (string, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, "/rest/api/3/issues/archive/export", request)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := new(bulkSubmitResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return result.TaskID, resp, nil
+}
+
+// Bulk operation statuses reported in BulkOperationProgress.Status.
+const (
+	BulkOperationEnqueued        = "ENQUEUED"
+	BulkOperationRunning         = "RUNNING"
+	BulkOperationComplete        = "COMPLETE"
+	BulkOperationFailed          = "FAILED"
+	BulkOperationCancelRequested = "CANCEL_REQUESTED"
+	BulkOperationCancelled       = "CANCELLED"
+	BulkOperationDead            = "DEAD"
+)
+
+// bulkSubmitResult is Jira's reply to a submitted bulk operation or
+// archived issue export.
+type bulkSubmitResult struct {
+	TaskID string `json:"taskId"`
+}
+
+// BulkDelete queues the deletion of up to 1,000 issues, including their
+// subtasks, and returns the ID of the task doing the work. Poll it with
+// GetBulkOperationProgress.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/#api-rest-api-3-bulk-issues-delete-post
+func (s *IssuesService) BulkDelete(ctx context.Context, issueIDsOrKeys []string) (string, *Response, error) {
+	body := map[string]any{
+		"selectedIssueIdsOrKeys": issueIDsOrKeys,
+	}
+	return s.submitBulk(ctx, "/rest/api/3/bulk/issues/delete", body)
+}
+
+// BulkMoveRequest represents a request to move issues between projects or
+// issue types.
+type BulkMoveRequest struct {
+	// SendBulkNotification controls the bulk change email; Jira sends it
+	// when nil.
+	SendBulkNotification *bool `json:"sendBulkNotification,omitempty"`
+
+	// TargetToSourcesMapping maps each destination, as built by
+	// BulkMoveTargetKey, to the issues moving there.
+	TargetToSourcesMapping map[string]*BulkMoveTarget `json:"targetToSourcesMapping"`
+}
+
+// BulkMoveTarget lists the issues moving to one destination and how Jira
+// should fill in what the destination needs.
+type BulkMoveTarget struct {
+	IssueIDsOrKeys              []string         `json:"issueIdsOrKeys"`
+	InferClassificationDefaults bool             `json:"inferClassificationDefaults"`
+	InferFieldDefaults          bool             `json:"inferFieldDefaults"`
+	InferStatusDefaults         bool             `json:"inferStatusDefaults"`
+	InferSubtaskTypeDefault     bool             `json:"inferSubtaskTypeDefault"`
+	TargetClassification        []map[string]any `json:"targetClassification,omitempty"`
+	TargetMandatoryFields       []map[string]any `json:"targetMandatoryFields,omitempty"`
+	TargetStatus                []map[string]any `json:"targetStatus,omitempty"`
+}
+
+// BulkMoveTargetKey returns the BulkMoveRequest.TargetToSourcesMapping key
+// for a destination project and issue type. parentIDOrKey is only needed
+// when moving subtasks, and may be empty.
+func BulkMoveTargetKey(projectIDOrKey, issueTypeID, parentIDOrKey string) string {
+	key := projectIDOrKey + "," + issueTypeID
+	if parentIDOrKey != "" {
+		key += "," + parentIDOrKey
+	}
+	return key
+}
+
+// BulkMove queues a move of issues to other projects or issue types and
+// returns the ID of the task doing the work. Poll it with
+// GetBulkOperationProgress.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/#api-rest-api-3-bulk-issues-move-post
+func (s *IssuesService) BulkMove(ctx context.Context, request *BulkMoveRequest) (string, *Response, error) {
+	return s.submitBulk(ctx, "/rest/api/3/bulk/issues/move", request)
+}
+
+// BulkTransitionRequest represents a request to transition issues.
+type BulkTransitionRequest struct {
+	BulkTransitionInputs []*BulkTransitionInput `json:"bulkTransitionInputs"`
+
+	// SendBulkNotification controls the bulk change email; Jira sends it
+	// when nil.
+	SendBulkNotification *bool `json:"sendBulkNotification,omitempty"`
+}
+
+// BulkTransitionInput applies one transition to a set of issues.
+type BulkTransitionInput struct {
+	SelectedIssueIDsOrKeys []string `json:"selectedIssueIdsOrKeys"`
+	TransitionID           string   `json:"transitionId"`
+}
+
+// BulkTransition queues transitions of up to 1,000 issues and returns the ID
+// of the task doing the work. Poll it with GetBulkOperationProgress.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/#api-rest-api-3-bulk-issues-transition-post
+func (s *IssuesService) BulkTransition(ctx context.Context, request *BulkTransitionRequest) (string, *Response, error) {
+	return s.submitBulk(ctx, "/rest/api/3/bulk/issues/transition", request)
+}
+
+func (s *IssuesService) submitBulk(ctx context.Context, u string, body any) (string, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := new(bulkSubmitResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return result.TaskID, resp, nil
+}
+
+// BulkOperationProgress represents the state of a queued bulk operation.
+type BulkOperationProgress struct {
+	TaskID                          string              `json:"taskId,omitempty"`
+	Status                          string              `json:"status,omitempty"` // One of the BulkOperation* constants
+	ProgressPercent                 int                 `json:"progressPercent,omitempty"`
+	Created                         int64               `json:"created,omitempty"` // Milliseconds since the epoch
+	Started                         int64               `json:"started,omitempty"`
+	Updated                         int64               `json:"updated,omitempty"`
+	SubmittedBy                     *User               `json:"submittedBy,omitempty"`
+	TotalIssueCount                 int                 `json:"totalIssueCount,omitempty"`
+	ProcessedAccessibleIssues       []int64             `json:"processedAccessibleIssues,omitempty"`
+	FailedAccessibleIssues          map[string][]string `json:"failedAccessibleIssues,omitempty"` // Issue ID to error messages
+	InvalidOrInaccessibleIssueCount int                 `json:"invalidOrInaccessibleIssueCount,omitempty"`
+}
+
+// Done reports whether the operation has stopped, successfully or not.
+func (p *BulkOperationProgress) Done() bool {
+	switch p.Status {
+	case BulkOperationComplete, BulkOperationFailed, BulkOperationCancelled, BulkOperationDead:
+		return true
+	}
+	return false
+}
+
+// GetBulkOperationProgress returns the progress of a bulk operation started
+// by BulkDelete, BulkMove or BulkTransition.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-bulk-operations/#api-rest-api-3-bulk-queue-taskid-get
+func (s *IssuesService) GetBulkOperationProgress(ctx context.Context, taskID string) (*BulkOperationProgress, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/bulk/queue/%s", taskID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(BulkOperationProgress)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
@@ -3,9 +3,19 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/aaronmaturen/go-jira/jira/adf"
 )
 
 func TestIssuesService_Get(t *testing.T) {
@@ -64,6 +74,150 @@ func TestIssuesService_Get_WithExpand(t *testing.T) {
 	}
 }
 
+func TestIssuesService_Get_FullDetail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := "names,changelog,transitions,operations,editmeta,renderedFields"
+		if got := r.URL.Query().Get("expand"); got != want {
+			t.Errorf("expand = %v, want %v", got, want)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"key": "TEST-1",
+			"transitions": [{"id": "11", "name": "To Do"}],
+			"operations": {"linkGroups": [{"id": "view.issue.opsbar"}]},
+			"editmeta": {"fields": {"summary": {"required": true, "name": "Summary"}}},
+			"changelog": {"total": 1, "histories": [{"id": "100", "items": [{"field": "status", "toString": "Done"}]}]},
+			"renderedFields": {"description": "<p>Hello</p>"}
+		}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	opts := &IssueGetOptions{Expand: []string{"names", "changelog"}, FullDetail: true}
+	issue, _, err := client.Issues.Get(context.Background(), "TEST-1", opts)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(issue.Transitions) != 1 || issue.Transitions[0].ID != "11" {
+		t.Errorf("Transitions = %+v", issue.Transitions)
+	}
+	if issue.Operations == nil || len(issue.Operations.LinkGroups) != 1 {
+		t.Errorf("Operations = %+v", issue.Operations)
+	}
+	if issue.Editmeta == nil || issue.Editmeta.Fields["summary"] == nil || !issue.Editmeta.Fields["summary"].Required {
+		t.Errorf("Editmeta = %+v", issue.Editmeta)
+	}
+	if issue.Changelog == nil || len(issue.Changelog.Histories) != 1 || issue.Changelog.Histories[0].Items[0].ToString != "Done" {
+		t.Errorf("Changelog = %+v", issue.Changelog)
+	}
+	if issue.RenderedFields["description"] != "<p>Hello</p>" {
+		t.Errorf("RenderedFields = %v", issue.RenderedFields)
+	}
+}
+
+func TestIssuesService_Get_IfNoneMatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v2"`)
+		if r.Header.Get("If-None-Match") == `"v2"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Issue{Key: "TEST-1"})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	_, resp, err := client.Issues.Get(context.Background(), "TEST-1", &IssueGetOptions{IfNoneMatch: `"v1"`})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if resp.ETag != `"v2"` {
+		t.Errorf("ETag = %v, want %v", resp.ETag, `"v2"`)
+	}
+
+	_, _, err = client.Issues.Get(context.Background(), "TEST-1", &IssueGetOptions{IfNoneMatch: resp.ETag})
+	if !errors.Is(err, ErrNotModified) {
+		t.Errorf("Get() error = %v, want %v", err, ErrNotModified)
+	}
+}
+
+func TestIssuesService_Get_IfModifiedSince(t *testing.T) {
+	updated := time.Date(2024, 3, 4, 10, 15, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Last-Modified", updated.Format(http.TimeFormat))
+		if since, err := http.ParseTime(r.Header.Get("If-Modified-Since")); err == nil && !updated.After(since) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Issue{Key: "TEST-1"})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	since := updated.Add(-time.Hour).In(time.FixedZone("EST", -5*60*60))
+	issue, resp, err := client.Issues.Get(context.Background(), "TEST-1", &IssueGetOptions{IfModifiedSince: since})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if issue.Key != "TEST-1" {
+		t.Errorf("Key = %v, want %v", issue.Key, "TEST-1")
+	}
+	if !resp.LastModified.Equal(updated) {
+		t.Errorf("LastModified = %v, want %v", resp.LastModified, updated)
+	}
+
+	issue, resp, err = client.Issues.Get(context.Background(), "TEST-1", &IssueGetOptions{IfModifiedSince: resp.LastModified})
+	if !errors.Is(err, ErrNotModified) {
+		t.Errorf("Get() error = %v, want %v", err, ErrNotModified)
+	}
+	if issue != nil || resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Get() = %v, %v, want nil issue and 304", issue, resp.StatusCode)
+	}
+}
+
+func TestIssuesService_Get_UpdateHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("updateHistory"); got != "true" {
+			t.Errorf("updateHistory = %v, want %v", got, "true")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Issue{Key: "TEST-1"})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	_, _, err := client.Issues.Get(context.Background(), "TEST-1", &IssueGetOptions{UpdateHistory: true})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+}
+
+func TestIssuesService_GetRecentlyViewed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := "issue in issueHistory() ORDER BY lastViewed DESC"
+		if got := r.URL.Query().Get("jql"); got != want {
+			t.Errorf("jql = %v, want %v", got, want)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SearchResult{Issues: []*Issue{{Key: "TEST-2"}, {Key: "TEST-1"}}})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	issues, _, err := client.Issues.GetRecentlyViewed(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("GetRecentlyViewed() error = %v", err)
+	}
+	if len(issues) != 2 || issues[0].Key != "TEST-2" {
+		t.Errorf("issues = %v, want [TEST-2 TEST-1]", issues)
+	}
+}
+
 func TestIssuesService_Create(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
@@ -228,6 +382,47 @@ func TestIssuesService_Assign(t *testing.T) {
 	}
 }
 
+func TestIssuesService_AssignSentinels(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func(*Client) (*Response, error)
+		wantBody string
+	}{
+		{"AssignAutomatic", func(c *Client) (*Response, error) {
+			return c.Issues.AssignAutomatic(context.Background(), "TEST-1")
+		}, `{"accountId":"-1"}`},
+		{"Unassign", func(c *Client) (*Response, error) {
+			return c.Issues.Unassign(context.Background(), "TEST-1")
+		}, `{"accountId":null}`},
+		{"Assign empty", func(c *Client) (*Response, error) {
+			return c.Issues.Assign(context.Background(), "TEST-1", "")
+		}, `{"accountId":null}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("Method = %v, want %v", r.Method, http.MethodPut)
+				}
+				if want := "/rest/api/3/issue/TEST-1/assignee"; r.URL.Path != want {
+					t.Errorf("URL path = %v, want %v", r.URL.Path, want)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body = %v, want %v", got, tt.wantBody)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			if _, err := tt.call(client); err != nil {
+				t.Errorf("%s() error = %v", tt.name, err)
+			}
+		})
+	}
+}
+
 func TestIssueFields_JSON(t *testing.T) {
 	input := `{
 		"summary": "Test issue",
@@ -271,3 +466,1461 @@ func TestIssueFields_JSON(t *testing.T) {
 		t.Errorf("len(Labels) = %v, want %v", len(fields.Labels), 2)
 	}
 }
+
+func TestChangeItem_Accessors(t *testing.T) {
+	assignee := &ChangeItem{Field: "assignee", FieldType: "jira", FieldID: "assignee", From: "abc", FromString: "Alice", To: "def", ToString: "Bob"}
+	if u := assignee.FromUser(); u == nil || u.AccountID != "abc" || u.DisplayName != "Alice" {
+		t.Errorf("FromUser() = %+v", u)
+	}
+	if u := assignee.ToUser(); u == nil || u.AccountID != "def" {
+		t.Errorf("ToUser() = %+v", u)
+	}
+	if st := assignee.ToStatus(); st != nil {
+		t.Errorf("ToStatus() on assignee change = %+v, want nil", st)
+	}
+
+	unassigned := &ChangeItem{Field: "assignee", FieldType: "jira", From: "abc", FromString: "Alice"}
+	if u := unassigned.ToUser(); u != nil {
+		t.Errorf("ToUser() after unassign = %+v, want nil", u)
+	}
+
+	status := &ChangeItem{Field: "status", FieldType: "jira", FieldID: "status", From: "1", FromString: "Open", To: "3", ToString: "In Progress"}
+	if st := status.ToStatus(); st == nil || st.ID != "3" || st.Name != "In Progress" {
+		t.Errorf("ToStatus() = %+v", st)
+	}
+
+	labels := &ChangeItem{Field: "labels", FieldType: "jira", FieldID: "labels", FromString: "a", ToString: "a b"}
+	if got := labels.ToLabels(); len(got) != 2 || got[1] != "b" {
+		t.Errorf("ToLabels() = %v, want [a b]", got)
+	}
+
+	custom := &ChangeItem{Field: "Status", FieldType: "custom", FieldID: "customfield_10001", To: "x"}
+	if st := custom.ToStatus(); st != nil {
+		t.Errorf("ToStatus() on custom field = %+v, want nil", st)
+	}
+}
+
+func TestIssueFields_CustomFields(t *testing.T) {
+	input := `{"summary":"Test issue","customfield_10010":"text","customfield_10020":[{"id":1,"name":"Sprint 1"}],"customfield_10030":{"value":"Red","child":{"value":"Dark"}},"customfield_10040":12345678901234567890,"customfield_10050":null}`
+
+	var fields IssueFields
+	if err := json.Unmarshal([]byte(input), &fields); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if fields.Summary != "Test issue" {
+		t.Errorf("Summary = %v, want %v", fields.Summary, "Test issue")
+	}
+	if _, ok := fields.Unknowns["summary"]; ok {
+		t.Error("Unknowns contains known field summary")
+	}
+	if fields.Unknowns["customfield_10010"] != "text" {
+		t.Errorf("customfield_10010 = %v, want %v", fields.Unknowns["customfield_10010"], "text")
+	}
+	if sprints, ok := fields.Unknowns["customfield_10020"].([]any); !ok || len(sprints) != 1 {
+		t.Errorf("customfield_10020 = %#v", fields.Unknowns["customfield_10020"])
+	}
+	if _, ok := fields.Unknowns["customfield_10050"]; !ok {
+		t.Error("customfield_10050 missing from Unknowns")
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	var got, want map[string]any
+	json.Unmarshal(data, &got)
+	json.Unmarshal([]byte(input), &want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip = %s, want %s", data, input)
+	}
+	if !strings.Contains(string(data), "12345678901234567890") {
+		t.Errorf("round trip lost number precision: %s", data)
+	}
+}
+
+func TestIssueFields_CustomFieldAccessors(t *testing.T) {
+	input := `{
+		"customfield_1": "text",
+		"customfield_2": {"id": "10001", "value": "Red"},
+		"customfield_3": [{"id": "1", "value": "A"}, {"id": 2, "value": "B"}],
+		"customfield_4": {"accountId": "abc", "displayName": "Alice"},
+		"customfield_5": {"id": "1", "value": "Parent", "child": {"id": "2", "value": "Child"}},
+		"customfield_6": [{"id": "1", "value": "A"}, "not an option"],
+		"customfield_7": {"id": "1"},
+		"customfield_8": 42
+	}`
+	var fields IssueFields
+	if err := json.Unmarshal([]byte(input), &fields); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+
+	if v, ok := fields.CustomFieldString("customfield_1"); !ok || v != "text" {
+		t.Errorf("CustomFieldString() = %v, %v", v, ok)
+	}
+	if o, ok := fields.CustomFieldOption("customfield_2"); !ok || o.ID != "10001" || o.Value != "Red" {
+		t.Errorf("CustomFieldOption() = %+v, %v", o, ok)
+	}
+	if opts, ok := fields.CustomFieldOptions("customfield_3"); !ok || len(opts) != 2 || opts[1].ID != "2" {
+		t.Errorf("CustomFieldOptions() = %+v, %v", opts, ok)
+	}
+	if u, ok := fields.CustomFieldUser("customfield_4"); !ok || u.AccountID != "abc" || u.DisplayName != "Alice" {
+		t.Errorf("CustomFieldUser() = %+v, %v", u, ok)
+	}
+	if p, c, ok := fields.CustomFieldCascade("customfield_5"); !ok || p.Value != "Parent" || c == nil || c.Value != "Child" {
+		t.Errorf("CustomFieldCascade() = %+v, %+v, %v", p, c, ok)
+	}
+
+	// Absent and malformed values report false rather than panicking.
+	if _, ok := fields.CustomFieldString("customfield_8"); ok {
+		t.Error("CustomFieldString() ok for number")
+	}
+	if _, ok := fields.CustomFieldString("missing"); ok {
+		t.Error("CustomFieldString() ok for missing field")
+	}
+	if _, ok := fields.CustomFieldOption("customfield_7"); ok {
+		t.Error("CustomFieldOption() ok for option without value")
+	}
+	if _, ok := fields.CustomFieldOption("customfield_3"); ok {
+		t.Error("CustomFieldOption() ok for array")
+	}
+	if _, ok := fields.CustomFieldOptions("customfield_6"); ok {
+		t.Error("CustomFieldOptions() ok for mixed array")
+	}
+	if _, ok := fields.CustomFieldUser("customfield_2"); ok {
+		t.Error("CustomFieldUser() ok for option")
+	}
+	if _, _, ok := fields.CustomFieldCascade("customfield_1"); ok {
+		t.Error("CustomFieldCascade() ok for string")
+	}
+	var nilFields *IssueFields
+	if _, ok := nilFields.CustomFieldUser("customfield_4"); ok {
+		t.Error("CustomFieldUser() ok on nil fields")
+	}
+}
+
+func TestIssueFields_MarshalKnownFieldsWin(t *testing.T) {
+	fields := IssueFields{
+		Summary:  "Real",
+		Unknowns: map[string]any{"summary": "Ignored", "customfield_10010": 5},
+	}
+	data, err := json.Marshal(&fields)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(data) != `{"customfield_10010":5,"summary":"Real"}` {
+		t.Errorf("Marshal = %s", data)
+	}
+}
+
+func TestNewHistoryMetadata(t *testing.T) {
+	meta := NewHistoryMetadata("Synced from CI").
+		WithActor("ci-bot", "CI Bot", "https://ci.example.com").
+		WithCause("1234", "build").
+		WithExtraData("build", "1234")
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if got["activityDescription"] != "Synced from CI" {
+		t.Errorf("activityDescription = %v, want %v", got["activityDescription"], "Synced from CI")
+	}
+	if _, ok := got["activityDescriptionKey"]; ok {
+		t.Error("activityDescriptionKey should be omitted")
+	}
+	actor, _ := got["actor"].(map[string]any)
+	if actor["id"] != "ci-bot" || actor["displayName"] != "CI Bot" {
+		t.Errorf("actor = %v", actor)
+	}
+	cause, _ := got["cause"].(map[string]any)
+	if cause["type"] != "build" {
+		t.Errorf("cause.type = %v, want %v", cause["type"], "build")
+	}
+	extra, _ := got["extraData"].(map[string]any)
+	if extra["build"] != "1234" {
+		t.Errorf("extraData.build = %v, want %v", extra["build"], "1234")
+	}
+}
+
+func TestIssuesService_Assign_UserIdentifierMode(t *testing.T) {
+	tests := []struct {
+		mode     UserIdentifierMode
+		wantBody string
+	}{
+		{UserIdentifierAccountID, `{"accountId":"jdoe"}`},
+		{UserIdentifierUsername, `{"name":"jdoe"}`},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body = %v, want %v", got, tt.wantBody)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL, WithUserIdentifierMode(tt.mode))
+			if _, err := client.Issues.Assign(context.Background(), "TEST-1", "jdoe"); err != nil {
+				t.Fatalf("Assign() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestIssuesService_AssignByDisplayName(t *testing.T) {
+	var assigned string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/user/assignable/search":
+			if got := r.URL.Query().Get("issueKey"); got != "TEST-1" {
+				t.Errorf("issueKey = %v, want %v", got, "TEST-1")
+			}
+			w.Header().Set("Content-Type", "application/json")
+			switch strings.ToLower(r.URL.Query().Get("query")) {
+			case "alice":
+				json.NewEncoder(w).Encode([]*User{{AccountID: "a1", DisplayName: "Alice"}, {AccountID: "a2", DisplayName: "Alice Smith"}})
+			case "bob":
+				json.NewEncoder(w).Encode([]*User{{AccountID: "b1", DisplayName: "Bob"}, {AccountID: "b2", DisplayName: "bob"}})
+			default:
+				w.Write([]byte(`[]`))
+			}
+		case "/rest/api/3/issue/TEST-1/assignee":
+			var req map[string]string
+			json.NewDecoder(r.Body).Decode(&req)
+			assigned = req["accountId"]
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	if _, err := client.Issues.AssignByDisplayName(context.Background(), "TEST-1", "alice"); err != nil {
+		t.Fatalf("AssignByDisplayName() error = %v", err)
+	}
+	if assigned != "a1" {
+		t.Errorf("assigned = %v, want %v", assigned, "a1")
+	}
+
+	if _, err := client.Issues.AssignByDisplayName(context.Background(), "TEST-1", "Bob"); err == nil {
+		t.Error("AssignByDisplayName() expected error for ambiguous name")
+	}
+	if _, err := client.Issues.AssignByDisplayName(context.Background(), "TEST-1", "Carol"); err == nil {
+		t.Error("AssignByDisplayName() expected error for unknown name")
+	}
+}
+
+func TestIssuesService_AssignByQuery(t *testing.T) {
+	var assigned []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/user/assignable/search":
+			if got := r.URL.Query().Get("issueKey"); got != "TEST-1" {
+				t.Errorf("issueKey = %v, want %v", got, "TEST-1")
+			}
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Query().Get("query") {
+			case "carol@example.com":
+				json.NewEncoder(w).Encode([]*User{{AccountID: "c1", DisplayName: "Carol Jones", EmailAddress: "carol@example.com"}})
+			case "Alice":
+				json.NewEncoder(w).Encode([]*User{{AccountID: "a1", DisplayName: "Alice"}, {AccountID: "a2", DisplayName: "Alice Smith"}})
+			case "Bob":
+				json.NewEncoder(w).Encode([]*User{{AccountID: "b1", DisplayName: "Bob Brown"}, {AccountID: "b2", DisplayName: "Bob Green"}})
+			default:
+				w.Write([]byte(`[]`))
+			}
+		case "/rest/api/3/issue/TEST-1/assignee":
+			var req map[string]string
+			json.NewDecoder(r.Body).Decode(&req)
+			assigned = append(assigned, req["accountId"])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+
+	for _, query := range []string{"carol@example.com", "Alice"} {
+		if _, err := client.Issues.AssignByQuery(ctx, "TEST-1", query); err != nil {
+			t.Fatalf("AssignByQuery(%q) error = %v", query, err)
+		}
+	}
+	if want := []string{"c1", "a1"}; !reflect.DeepEqual(assigned, want) {
+		t.Errorf("assigned = %v, want %v", assigned, want)
+	}
+
+	if _, err := client.Issues.AssignByQuery(ctx, "TEST-1", "Bob"); err == nil || !strings.Contains(err.Error(), "Bob Green (b2)") {
+		t.Errorf("AssignByQuery(Bob) error = %v, want an ambiguity error listing the matches", err)
+	}
+	if _, err := client.Issues.AssignByQuery(ctx, "TEST-1", "Dave"); err == nil {
+		t.Error("AssignByQuery(Dave) error = nil, want error for no match")
+	}
+	if len(assigned) != 2 {
+		t.Errorf("assignments = %v, want 2", len(assigned))
+	}
+}
+
+func TestIssuesService_AssignByQuery_UsernameMode(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/user/assignable/search":
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Query().Get("query") {
+			case "Alice":
+				w.Write([]byte(`[{"name":"alice","displayName":"Alice"}]`))
+			default:
+				w.Write([]byte(`[{"displayName":"Ghost"}]`))
+			}
+		case "/rest/api/2/issue/TEST-1/assignee":
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, strings.TrimSpace(string(body)))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL, WithAPIVersion("2"), WithUserIdentifierMode(UserIdentifierUsername))
+	ctx := context.Background()
+
+	if _, err := client.Issues.AssignByQuery(ctx, "TEST-1", "Alice"); err != nil {
+		t.Fatalf("AssignByQuery() error = %v", err)
+	}
+	if _, err := client.Issues.AssignByDisplayName(ctx, "TEST-1", "Alice"); err != nil {
+		t.Fatalf("AssignByDisplayName() error = %v", err)
+	}
+	if want := []string{`{"name":"alice"}`, `{"name":"alice"}`}; !reflect.DeepEqual(bodies, want) {
+		t.Errorf("assignee bodies = %v, want %v", bodies, want)
+	}
+
+	// A user without a username must not unassign the issue.
+	if _, err := client.Issues.AssignByQuery(ctx, "TEST-1", "Ghost"); err == nil {
+		t.Error("AssignByQuery(Ghost) error = nil, want error for a user without a username")
+	}
+	if _, err := client.Issues.AssignByDisplayName(ctx, "TEST-1", "Ghost"); err == nil {
+		t.Error("AssignByDisplayName(Ghost) error = nil, want error for a user without a username")
+	}
+	if len(bodies) != 2 {
+		t.Errorf("assignments = %v, want 2", len(bodies))
+	}
+}
+
+func TestIssuesService_AssignByJQL(t *testing.T) {
+	var mu sync.Mutex
+	assigned := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/api/3/search/jql":
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("nextPageToken") == "" {
+				json.NewEncoder(w).Encode(SearchResult{
+					Issues:        []*Issue{{Key: "TEST-1"}, {Key: "TEST-2"}},
+					NextPageToken: "page2",
+				})
+				return
+			}
+			json.NewEncoder(w).Encode(SearchResult{
+				Issues: []*Issue{{Key: "TEST-3"}},
+			})
+		case strings.HasSuffix(r.URL.Path, "/assignee"):
+			key := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/rest/api/3/issue/"), "/assignee")
+			if key == "TEST-2" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			var req map[string]string
+			json.NewDecoder(r.Body).Decode(&req)
+			mu.Lock()
+			assigned[key] = req["accountId"]
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	count, errs := client.Issues.AssignByJQL(context.Background(), "assignee = bob", "alice", 2)
+	if count != 2 {
+		t.Errorf("count = %v, want %v", count, 2)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("len(errs) = %v, want %v", len(errs), 1)
+	}
+	if !strings.Contains(errs[0].Error(), "TEST-2") {
+		t.Errorf("errs[0] = %v, want mention of TEST-2", errs[0])
+	}
+	if assigned["TEST-1"] != "alice" || assigned["TEST-3"] != "alice" {
+		t.Errorf("assigned = %v", assigned)
+	}
+}
+
+func TestIssuesService_SetPropertyByJQL(t *testing.T) {
+	var mu sync.Mutex
+	set := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/api/3/search/jql":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(SearchResult{
+				Issues: []*Issue{{Key: "TEST-1"}, {Key: "TEST-2"}},
+			})
+		case strings.HasSuffix(r.URL.Path, "/properties/migrated") && r.Method == http.MethodPut:
+			key := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/rest/api/3/issue/"), "/properties/migrated")
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			set[key] = strings.TrimSpace(string(body))
+			mu.Unlock()
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	value := map[string]bool{"done": true}
+	count, errs := client.Issues.SetPropertyByJQL(context.Background(), "project = TEST", "migrated", value, 2)
+	if count != 2 || len(errs) != 0 {
+		t.Fatalf("count = %v, errs = %v", count, errs)
+	}
+	if set["TEST-1"] != `{"done":true}` || set["TEST-2"] != `{"done":true}` {
+		t.Errorf("set = %v", set)
+	}
+}
+
+func TestIssuesService_DeletePropertyByJQL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/api/3/search/jql":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(SearchResult{
+				Issues: []*Issue{{Key: "TEST-1"}, {Key: "TEST-2"}},
+			})
+		case r.Method == http.MethodDelete && r.URL.Path == "/rest/api/3/issue/TEST-1/properties/migrated":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && r.URL.Path == "/rest/api/3/issue/TEST-2/properties/migrated":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	count, errs := client.Issues.DeletePropertyByJQL(context.Background(), "project = TEST", "migrated", 1)
+	if count != 1 {
+		t.Errorf("count = %v, want %v", count, 1)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "TEST-2") {
+		t.Errorf("errs = %v, want one error mentioning TEST-2", errs)
+	}
+}
+
+func TestIssuesService_DeletePropertyByJQL_RepeatedToken(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		searches int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/3/search/jql" {
+			mu.Lock()
+			searches++
+			n := searches
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			if n > 2 {
+				t.Errorf("search request %d, want the repeated token to end the search", n)
+				w.Write([]byte(`{"isLast":true}`))
+				return
+			}
+			json.NewEncoder(w).Encode(SearchResult{
+				Issues:        []*Issue{{Key: fmt.Sprintf("TEST-%d", n)}},
+				NextPageToken: "stuck",
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	count, errs := client.Issues.DeletePropertyByJQL(context.Background(), "project = TEST", "migrated", 1)
+	if count != 2 || len(errs) != 0 {
+		t.Errorf("count = %v, errs = %v, want 2 and none", count, errs)
+	}
+}
+
+func TestIssuesService_CreateMetaDefaults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/createmeta" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/issue/createmeta")
+		}
+		if got := r.URL.Query().Get("expand"); got != "projects.issuetypes.fields" {
+			t.Errorf("expand = %v, want %v", got, "projects.issuetypes.fields")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(CreateMeta{
+			Projects: []*CreateMetaProject{{
+				Key: "TEST",
+				IssueTypes: []*CreateMetaIssueType{{
+					Name: "Bug",
+					Fields: map[string]*FieldMeta{
+						"priority": {HasDefaultValue: true, DefaultValue: map[string]any{"id": "3"}},
+						"summary":  {Required: true},
+					},
+				}},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	fields, err := client.Issues.CreateMetaDefaults(context.Background(), "TEST", "Bug")
+	if err != nil {
+		t.Fatalf("CreateMetaDefaults() error = %v", err)
+	}
+	if len(fields) != 1 {
+		t.Fatalf("len(fields) = %v, want %v", len(fields), 1)
+	}
+	if priority, ok := fields["priority"].(map[string]any); !ok || priority["id"] != "3" {
+		t.Errorf("priority = %v, want id 3", fields["priority"])
+	}
+
+	if _, err := client.Issues.CreateMetaDefaults(context.Background(), "TEST", "Story"); err == nil {
+		t.Error("CreateMetaDefaults() expected error for unknown issue type")
+	}
+}
+
+func TestIssuesService_TransitionToStatus(t *testing.T) {
+	var performed string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var req IssueTransitionRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			performed = req.Transition.ID
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"transitions": []*Transition{
+				{ID: "11", Name: "Start Progress", To: &Status{Name: "In Progress"}},
+				{ID: "21", Name: "Resolve", To: &Status{Name: "Done"}},
+				{ID: "31", Name: "Close", To: &Status{Name: "Done"}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+
+	if err := client.Issues.TransitionToStatus(ctx, "TEST-1", "in progress", nil); err != nil {
+		t.Fatalf("TransitionToStatus() error = %v", err)
+	}
+	if performed != "11" {
+		t.Errorf("transition ID = %v, want %v", performed, "11")
+	}
+
+	if err := client.Issues.TransitionToStatus(ctx, "TEST-1", "Done", nil); err == nil {
+		t.Error("TransitionToStatus() expected error for ambiguous status")
+	}
+	if err := client.Issues.TransitionToStatus(ctx, "TEST-1", "Blocked", nil); err == nil {
+		t.Error("TransitionToStatus() expected error for unreachable status")
+	}
+}
+
+func TestIssuesService_TransitionByName(t *testing.T) {
+	var performed IssueTransitionRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			json.NewDecoder(r.Body).Decode(&performed)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"transitions": []*Transition{
+				{ID: "11", Name: "Start Progress", To: &Status{Name: "In Progress"}},
+				{ID: "21", Name: "Resolve", To: &Status{Name: "Done"}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+
+	fields := map[string]any{"resolution": map[string]any{"name": "Fixed"}}
+	if _, err := client.Issues.TransitionByName(ctx, "TEST-1", "resolve", fields); err != nil {
+		t.Fatalf("TransitionByName() error = %v", err)
+	}
+	if performed.Transition.ID != "21" {
+		t.Errorf("transition ID = %v, want %v", performed.Transition.ID, "21")
+	}
+	if !reflect.DeepEqual(performed.Fields, fields) {
+		t.Errorf("fields = %v, want %v", performed.Fields, fields)
+	}
+
+	_, err := client.Issues.TransitionByName(ctx, "TEST-1", "Reopen", nil)
+	if err == nil || !strings.Contains(err.Error(), "Start Progress, Resolve") {
+		t.Errorf("TransitionByName() error = %v, want an error listing the available transitions", err)
+	}
+}
+
+func TestIssuesService_Update_OverrideFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *IssueUpdateOptions
+		want string
+	}{
+		{name: "nil options", opts: nil, want: ""},
+		{name: "flags unset", opts: &IssueUpdateOptions{}, want: ""},
+		{
+			name: "flags set",
+			opts: &IssueUpdateOptions{OverrideScreenSecurity: true, OverrideEditableFlag: true},
+			want: "overrideEditableFlag=true&overrideScreenSecurity=true",
+		},
+		{
+			name: "notify disabled",
+			opts: &IssueUpdateOptions{NotifyUsers: Bool(false)},
+			want: "notifyUsers=false",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.RawQuery != tt.want {
+					t.Errorf("query = %v, want %v", r.URL.RawQuery, tt.want)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			_, err := client.Issues.Update(context.Background(), "TEST-1", &IssueUpdateRequest{}, tt.opts)
+			if err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestIssuesService_GetEditMeta_OverrideFlags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := "overrideEditableFlag=true&overrideScreenSecurity=true"
+		if r.URL.RawQuery != want {
+			t.Errorf("query = %v, want %v", r.URL.RawQuery, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(EditMeta{})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	_, _, err := client.Issues.GetEditMeta(context.Background(), "TEST-1", &EditMetaOptions{
+		OverrideScreenSecurity: true,
+		OverrideEditableFlag:   true,
+	})
+	if err != nil {
+		t.Fatalf("GetEditMeta() error = %v", err)
+	}
+}
+
+func TestIssuesService_GetMany(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/bulkfetch" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/issue/bulkfetch")
+		}
+		requests++
+
+		var req BulkFetchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		result := BulkFetchResult{}
+		for _, key := range req.IssueIDsOrKeys {
+			switch key {
+			case "TEST-1":
+				result.Issues = append(result.Issues, &Issue{ID: "10001", Key: "TEST-1"})
+			case "10002":
+				result.Issues = append(result.Issues, &Issue{ID: "10002", Key: "TEST-2"})
+			case "TEST-3":
+				result.IssueErrors = append(result.IssueErrors, &BulkIssueError{ID: "TEST-3", ErrorMessage: "try again"})
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	keys := []string{"TEST-4", "TEST-1", "10002", "TEST-3"}
+	for i := 0; i < 100; i++ {
+		keys = append(keys, fmt.Sprintf("GONE-%d", i))
+	}
+
+	client, _ := NewClient(server.URL)
+	issues, missing, err := client.Issues.GetMany(context.Background(), keys, nil)
+
+	var issueErr *BulkIssueError
+	if !errors.As(err, &issueErr) || issueErr.ID != "TEST-3" {
+		t.Errorf("err = %v, want BulkIssueError for TEST-3", err)
+	}
+	if requests != 2 {
+		t.Errorf("requests = %v, want %v", requests, 2)
+	}
+	if len(issues) != 2 || issues["TEST-1"] == nil || issues["10002"].Key != "TEST-2" {
+		t.Errorf("issues = %v", issues)
+	}
+	if len(missing) != 101 || missing[0] != "TEST-4" || missing[1] != "GONE-0" {
+		t.Errorf("missing = %v", missing[:2])
+	}
+}
+
+func TestIssuesService_GetMany_Duplicates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req BulkFetchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if want := []string{"TEST-1", "TEST-4"}; !reflect.DeepEqual(req.IssueIDsOrKeys, want) {
+			t.Errorf("issueIdsOrKeys = %v, want %v", req.IssueIDsOrKeys, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"issues":[{"id":"10001","key":"TEST-1"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	issues, missing, err := client.Issues.GetMany(context.Background(), []string{"TEST-1", "TEST-4", "TEST-1", "TEST-4"}, nil)
+	if err != nil {
+		t.Fatalf("GetMany() error = %v", err)
+	}
+	if len(issues) != 1 || issues["TEST-1"] == nil {
+		t.Errorf("issues = %v", issues)
+	}
+	if want := []string{"TEST-4"}; !reflect.DeepEqual(missing, want) {
+		t.Errorf("missing = %v, want %v", missing, want)
+	}
+}
+
+func TestIssueFieldsBuilder(t *testing.T) {
+	fields := NewIssueFieldsBuilder().
+		Project("PROJ").
+		IssueTypeID("10001").
+		Summary("Login fails").
+		Assignee("abc").
+		Priority("High").
+		Labels("auth", "regression").
+		Custom("customfield_10010", 5).
+		Build()
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"assignee":{"accountId":"abc"},"customfield_10010":5,"issuetype":{"id":"10001"},"labels":["auth","regression"],"priority":{"name":"High"},"project":{"key":"PROJ"},"summary":"Login fails"}`
+	if string(data) != want {
+		t.Errorf("fields = %s, want %s", data, want)
+	}
+}
+
+func TestIssuesService_GetWithSubtasks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/issue/TEST-1":
+			w.Write([]byte(`{"key":"TEST-1","fields":{"subtasks":[{"key":"TEST-2"},{"key":"TEST-3"}]}}`))
+		case "/rest/api/3/issue/bulkfetch":
+			var req BulkFetchRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if len(req.Fields) != 2 || req.Fields[1] != "customfield_10016" {
+				t.Errorf("fields = %v, want [summary customfield_10016]", req.Fields)
+			}
+			w.Write([]byte(`{"issues":[{"key":"TEST-2","fields":{"summary":"Full subtask","customfield_10016":3}}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	issue, err := client.Issues.GetWithSubtasks(context.Background(), "TEST-1", []string{"summary", "customfield_10016"})
+	if err != nil {
+		t.Fatalf("GetWithSubtasks() error = %v", err)
+	}
+	subtasks := issue.Fields.Subtasks
+	if len(subtasks) != 2 {
+		t.Fatalf("len(Subtasks) = %v, want %v", len(subtasks), 2)
+	}
+	if subtasks[0].Fields == nil || subtasks[0].Fields.Summary != "Full subtask" {
+		t.Errorf("Subtasks[0] = %+v, want full subtask", subtasks[0])
+	}
+	if subtasks[1].Key != "TEST-3" || subtasks[1].Fields != nil {
+		t.Errorf("Subtasks[1] = %+v, want stub", subtasks[1])
+	}
+}
+
+func TestIssuesService_CreateWithReporter(t *testing.T) {
+	tests := []struct {
+		name           string
+		havePermission bool
+		wantCreate     bool
+	}{
+		{name: "permitted", havePermission: true, wantCreate: true},
+		{name: "denied", havePermission: false, wantCreate: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/rest/api/3/mypermissions":
+					if got := r.URL.Query().Get("projectKey"); got != "TEST" {
+						t.Errorf("projectKey = %v, want %v", got, "TEST")
+					}
+					json.NewEncoder(w).Encode(PermissionsResult{
+						Permissions: map[string]*Permission{
+							"MODIFY_REPORTER": {Key: "MODIFY_REPORTER", HavePermission: tt.havePermission},
+						},
+					})
+				case "/rest/api/3/issue":
+					created = true
+					var req IssueCreateRequest
+					json.NewDecoder(r.Body).Decode(&req)
+					reporter, _ := req.Fields["reporter"].(map[string]any)
+					if reporter["accountId"] != "orig-reporter" {
+						t.Errorf("reporter = %v, want accountId orig-reporter", req.Fields["reporter"])
+					}
+					json.NewEncoder(w).Encode(IssueCreateResponse{Key: "TEST-1"})
+				}
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			fields := map[string]any{
+				"project": map[string]string{"key": "TEST"},
+				"summary": "Migrated issue",
+			}
+			_, _, err := client.Issues.CreateWithReporter(context.Background(), &IssueCreateRequest{Fields: fields}, "orig-reporter")
+			if _, ok := fields["reporter"]; ok {
+				t.Errorf("CreateWithReporter() added reporter to the caller's fields")
+			}
+
+			if created != tt.wantCreate {
+				t.Errorf("created = %v, want %v", created, tt.wantCreate)
+			}
+			var permErr *PermissionError
+			if tt.wantCreate && err != nil {
+				t.Errorf("CreateWithReporter() error = %v", err)
+			}
+			if !tt.wantCreate && !errors.As(err, &permErr) {
+				t.Errorf("CreateWithReporter() error = %v, want *PermissionError", err)
+			}
+		})
+	}
+}
+
+func TestIssuesService_CreateWithReporter_NilIssue(t *testing.T) {
+	client, _ := NewClient("https://example.atlassian.net")
+	if _, _, err := client.Issues.CreateWithReporter(context.Background(), nil, "orig-reporter"); err == nil {
+		t.Error("CreateWithReporter(nil) error = nil, want error")
+	}
+}
+
+func TestIssueFields_TimeTrackingHelpers(t *testing.T) {
+	tests := []struct {
+		name          string
+		fields        *IssueFields
+		wantRemaining time.Duration
+		wantSpent     time.Duration
+		wantPercent   int
+		wantAggregate int
+	}{
+		{name: "nil fields"},
+		{name: "empty fields", fields: &IssueFields{}},
+		{
+			name: "top-level seconds",
+			fields: &IssueFields{
+				TimeEstimate:      3600,
+				TimeSpent:         1800,
+				Progress:          &Progress{Progress: 1800, Total: 5400, Percent: 33},
+				AggregateProgress: &Progress{Progress: 1800, Total: 7200},
+			},
+			wantRemaining: time.Hour,
+			wantSpent:     30 * time.Minute,
+			wantPercent:   33,
+			wantAggregate: 25,
+		},
+		{
+			name: "time tracking fallback",
+			fields: &IssueFields{
+				TimeTracking: &TimeTracking{RemainingEstimateSeconds: 7200, TimeSpentSeconds: 60},
+				Progress:     &Progress{Progress: 100, Total: 0},
+			},
+			wantRemaining: 2 * time.Hour,
+			wantSpent:     time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fields.RemainingEstimate(); got != tt.wantRemaining {
+				t.Errorf("RemainingEstimate() = %v, want %v", got, tt.wantRemaining)
+			}
+			if got := tt.fields.TimeSpentDuration(); got != tt.wantSpent {
+				t.Errorf("TimeSpentDuration() = %v, want %v", got, tt.wantSpent)
+			}
+			if got := tt.fields.PercentComplete(); got != tt.wantPercent {
+				t.Errorf("PercentComplete() = %v, want %v", got, tt.wantPercent)
+			}
+			if got := tt.fields.AggregatePercentComplete(); got != tt.wantAggregate {
+				t.Errorf("AggregatePercentComplete() = %v, want %v", got, tt.wantAggregate)
+			}
+		})
+	}
+}
+
+func TestIssue_StatusCategory(t *testing.T) {
+	withCategory := func(key string) *Issue {
+		return &Issue{Fields: &IssueFields{Status: &Status{Name: "Any", StatusCategory: &StatusCategory{Key: key}}}}
+	}
+	tests := []struct {
+		name                     string
+		issue                    *Issue
+		toDo, inProgress, isDone bool
+	}{
+		{"to do", withCategory(StatusCategoryToDo), true, false, false},
+		{"in progress", withCategory(StatusCategoryInProgress), false, true, false},
+		{"done", withCategory(StatusCategoryDone), false, false, true},
+		{"no category", &Issue{Fields: &IssueFields{Status: &Status{Name: "Open"}}}, false, false, false},
+		{"no status", &Issue{Fields: &IssueFields{}}, false, false, false},
+		{"no fields", &Issue{Key: "TEST-1"}, false, false, false},
+		{"nil issue", nil, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.issue.IsToDo(); got != tt.toDo {
+				t.Errorf("IsToDo() = %v, want %v", got, tt.toDo)
+			}
+			if got := tt.issue.IsInProgress(); got != tt.inProgress {
+				t.Errorf("IsInProgress() = %v, want %v", got, tt.inProgress)
+			}
+			if got := tt.issue.IsDone(); got != tt.isDone {
+				t.Errorf("IsDone() = %v, want %v", got, tt.isDone)
+			}
+		})
+	}
+}
+
+func TestIssuesService_BulkOperations(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func(*Client) (string, *Response, error)
+		wantPath string
+		wantBody string
+	}{
+		{
+			name: "BulkDelete",
+			call: func(c *Client) (string, *Response, error) {
+				return c.Issues.BulkDelete(context.Background(), []string{"TEST-1", "10002"})
+			},
+			wantPath: "/rest/api/3/bulk/issues/delete",
+			wantBody: `{"selectedIssueIdsOrKeys":["TEST-1","10002"]}`,
+		},
+		{
+			name: "BulkMove",
+			call: func(c *Client) (string, *Response, error) {
+				return c.Issues.BulkMove(context.Background(), &BulkMoveRequest{
+					SendBulkNotification: Bool(false),
+					TargetToSourcesMapping: map[string]*BulkMoveTarget{
+						BulkMoveTargetKey("DEST", "10001", ""): {
+							IssueIDsOrKeys:     []string{"TEST-1"},
+							InferFieldDefaults: true,
+						},
+					},
+				})
+			},
+			wantPath: "/rest/api/3/bulk/issues/move",
+			wantBody: `{"sendBulkNotification":false,"targetToSourcesMapping":{"DEST,10001":{"issueIdsOrKeys":["TEST-1"],"inferClassificationDefaults":false,"inferFieldDefaults":true,"inferStatusDefaults":false,"inferSubtaskTypeDefault":false}}}`,
+		},
+		{
+			name: "BulkTransition",
+			call: func(c *Client) (string, *Response, error) {
+				return c.Issues.BulkTransition(context.Background(), &BulkTransitionRequest{
+					BulkTransitionInputs: []*BulkTransitionInput{
+						{SelectedIssueIDsOrKeys: []string{"TEST-1", "TEST-2"}, TransitionID: "31"},
+					},
+				})
+			},
+			wantPath: "/rest/api/3/bulk/issues/transition",
+			wantBody: `{"bulkTransitionInputs":[{"selectedIssueIdsOrKeys":["TEST-1","TEST-2"],"transitionId":"31"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Method = %v, want %v", r.Method, http.MethodPost)
+				}
+				if r.URL.Path != tt.wantPath {
+					t.Errorf("URL path = %v, want %v", r.URL.Path, tt.wantPath)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body =\n%s\nwant\n%s", got, tt.wantBody)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"taskId":"10641"}`))
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			taskID, _, err := tt.call(client)
+			if err != nil {
+				t.Fatalf("%s() error = %v", tt.name, err)
+			}
+			if taskID != "10641" {
+				t.Errorf("task ID = %v, want %v", taskID, "10641")
+			}
+		})
+	}
+}
+
+func TestIssuesService_GetBulkOperationProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/bulk/queue/10641" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/bulk/queue/10641")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"taskId":"10641","status":"COMPLETE","progressPercent":100,"created":1704110400000,` +
+			`"totalIssueCount":2,"processedAccessibleIssues":[10001],"failedAccessibleIssues":{"10002":["Issue is locked"]}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	progress, _, err := client.Issues.GetBulkOperationProgress(context.Background(), "10641")
+	if err != nil {
+		t.Fatalf("GetBulkOperationProgress() error = %v", err)
+	}
+	if !progress.Done() || progress.ProgressPercent != 100 {
+		t.Errorf("progress = %+v, want complete", progress)
+	}
+	if got := progress.FailedAccessibleIssues["10002"]; len(got) != 1 {
+		t.Errorf("FailedAccessibleIssues = %v", progress.FailedAccessibleIssues)
+	}
+}
+
+func TestIssuesService_Properties(t *testing.T) {
+	stored := map[string]json.RawMessage{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const prefix = "/rest/api/3/issue/TEST-1/properties"
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			t.Errorf("URL path = %v, want prefix %v", r.URL.Path, prefix)
+		}
+		key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && key == "":
+			keys := []map[string]string{}
+			for k := range stored {
+				keys = append(keys, map[string]string{"key": k})
+			}
+			json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+		case r.Method == http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			stored[key] = json.RawMessage(strings.TrimSpace(string(body)))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet:
+			value, ok := stored[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"errorMessages":["The property with key '` + key + `' does not exist."]}`))
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{"key": key, "value": value})
+		case r.Method == http.MethodDelete:
+			delete(stored, key)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+
+	if _, err := client.Issues.SetProperty(ctx, "TEST-1", "sync", map[string]any{"revision": 3}); err != nil {
+		t.Fatalf("SetProperty() error = %v", err)
+	}
+	if got, want := string(stored["sync"]), `{"revision":3}`; got != want {
+		t.Errorf("stored value = %v, want %v", got, want)
+	}
+
+	keys, _, err := client.Issues.ListPropertyKeys(ctx, "TEST-1")
+	if err != nil {
+		t.Fatalf("ListPropertyKeys() error = %v", err)
+	}
+	if len(keys) != 1 || keys[0] != "sync" {
+		t.Errorf("keys = %v, want [sync]", keys)
+	}
+
+	prop, _, err := client.Issues.GetProperty(ctx, "TEST-1", "sync")
+	if err != nil {
+		t.Fatalf("GetProperty() error = %v", err)
+	}
+	if value, ok := prop.Value.(map[string]any); !ok || value["revision"] != float64(3) {
+		t.Errorf("Value = %v, want map[revision:3]", prop.Value)
+	}
+
+	if _, err := client.Issues.DeleteProperty(ctx, "TEST-1", "sync"); err != nil {
+		t.Fatalf("DeleteProperty() error = %v", err)
+	}
+	if _, _, err := client.Issues.GetProperty(ctx, "TEST-1", "sync"); !IsNotFound(err) {
+		t.Errorf("GetProperty() after delete error = %v, want not found", err)
+	}
+}
+
+func TestIssuesService_ExportArchived(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/issues/archive/export":
+			if r.Method != http.MethodPut {
+				t.Errorf("Method = %v, want %v", r.Method, http.MethodPut)
+			}
+			body, _ := io.ReadAll(r.Body)
+			want := `{"archivedBy":["557058:abc"],"archivedDateRange":{"dateAfter":"2024-01-01","dateBefore":"2024-03-31"},` +
+				`"issueTypes":["10001"],"projects":["TEST"]}`
+			if got := strings.TrimSpace(string(body)); got != want {
+				t.Errorf("body = %v, want %v", got, want)
+			}
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"taskId":"10990","status":"ENQUEUED","progress":0}`))
+		case "/rest/api/3/task/10990":
+			w.Write([]byte(`{"id":"10990","status":"COMPLETE","progress":100}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	taskID, _, err := client.Issues.ExportArchived(context.Background(), &ArchivedExportRequest{
+		ArchivedBy:        []string{"557058:abc"},
+		ArchivedDateRange: &ArchivedDateRange{DateAfter: "2024-01-01", DateBefore: "2024-03-31"},
+		IssueTypes:        []string{"10001"},
+		Projects:          []string{"TEST"},
+	})
+	if err != nil {
+		t.Fatalf("ExportArchived() error = %v", err)
+	}
+	if taskID != "10990" {
+		t.Errorf("taskID = %v, want %v", taskID, "10990")
+	}
+
+	task, err := client.Tasks.Wait(context.Background(), taskID, time.Millisecond)
+	if err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if task.Status != TaskComplete {
+		t.Errorf("Status = %v, want %v", task.Status, TaskComplete)
+	}
+}
+
+func TestIssuesService_ValidateUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/editmeta" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/issue/TEST-1/editmeta")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"fields":{
+			"summary":{"required":true,"name":"Summary","key":"summary","operations":["set"]},
+			"labels":{"required":false,"name":"Labels","key":"labels","operations":["add","remove"]},
+			"customfield_10016":{"required":false,"name":"Story Points","key":"customfield_10016","operations":["set"]}
+		}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+
+	tests := []struct {
+		name   string
+		fields map[string]any
+		want   []string
+	}{
+		{
+			name:   "valid",
+			fields: map[string]any{"summary": "New summary", "customfield_10016": nil},
+		},
+		{
+			name:   "unknown field",
+			fields: map[string]any{"summary": "New summary", "priority": map[string]any{"name": "High"}},
+			want:   []string{`field "priority" is not on the edit screen of TEST-1`},
+		},
+		{
+			name:   "required field cleared",
+			fields: map[string]any{"summary": nil},
+			want:   []string{"field Summary (summary) is required and cannot be cleared"},
+		},
+		{
+			name:   "set not allowed",
+			fields: map[string]any{"labels": []string{"backend"}},
+			want:   []string{"field Labels (labels) cannot be set, only: add, remove"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, err := client.Issues.ValidateUpdate(context.Background(), "TEST-1", tt.fields)
+			if err != nil {
+				t.Fatalf("ValidateUpdate() error = %v", err)
+			}
+			if !reflect.DeepEqual(problems, tt.want) {
+				t.Errorf("ValidateUpdate() = %q, want %q", problems, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssuesService_GetCreateMetaFields(t *testing.T) {
+	fields := []*FieldMeta{
+		{FieldID: "summary", Name: "Summary", Required: true},
+		{FieldID: "issuetype", Name: "Issue Type", Required: true},
+		{FieldID: "customfield_10016", Name: "Story Points"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/createmeta/TEST/issuetypes/10001" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/issue/createmeta/TEST/issuetypes/10001")
+		}
+		if got := r.URL.Query().Get("maxResults"); got != "2" {
+			t.Errorf("maxResults = %v, want %v", got, "2")
+		}
+
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		end := min(startAt+2, len(fields))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(CreateMetaFieldListResult{
+			StartAt:    startAt,
+			MaxResults: 2,
+			Total:      len(fields),
+			Fields:     fields[startAt:end],
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+
+	first, _, err := client.Issues.GetCreateMetaFields(ctx, "TEST", "10001", 0, 2)
+	if err != nil {
+		t.Fatalf("GetCreateMetaFields() error = %v", err)
+	}
+	if m := first.Map(); len(m) != 2 || !m["summary"].Required {
+		t.Errorf("Map() = %v, want summary and issuetype", m)
+	}
+
+	all := map[string]*FieldMeta{}
+	pages := Paginate(ctx, func(startAt int) ([]*FieldMeta, *Response, error) {
+		result, resp, err := client.Issues.GetCreateMetaFields(ctx, "TEST", "10001", startAt, 2)
+		if err != nil {
+			return nil, resp, err
+		}
+		return result.Fields, resp, nil
+	})
+	for field, err := range pages {
+		if err != nil {
+			t.Fatalf("GetCreateMetaFields() error = %v", err)
+		}
+		all[field.FieldID] = field
+	}
+	if len(all) != 3 || all["customfield_10016"].Name != "Story Points" {
+		t.Errorf("fields = %v, want all 3", all)
+	}
+}
+
+func TestIssuesService_GetCreateMetaIssueTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/createmeta/TEST/issuetypes" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/issue/createmeta/TEST/issuetypes")
+		}
+		if r.URL.RawQuery != "" {
+			t.Errorf("query = %v, want empty", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"startAt":0,"maxResults":50,"total":2,"issueTypes":[{"id":"10001","name":"Task"},{"id":"10002","name":"Sub-task","subtask":true}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, _, err := client.Issues.GetCreateMetaIssueTypes(context.Background(), "TEST", 0, 0)
+	if err != nil {
+		t.Fatalf("GetCreateMetaIssueTypes() error = %v", err)
+	}
+	if len(result.IssueTypes) != 2 || !result.IssueTypes[1].Subtask {
+		t.Errorf("IssueTypes = %+v", result.IssueTypes)
+	}
+}
+
+func TestIssuesService_GetAllChangelog(t *testing.T) {
+	pages := map[string]string{
+		"0": `{"startAt":0,"maxResults":2,"total":3,"isLast":false,"histories":[{"id":"1"},{"id":"2"}]}`,
+		"2": `{"startAt":2,"maxResults":2,"total":3,"isLast":true,"histories":[{"id":"3"}]}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want := "/rest/api/3/issue/TEST-1/changelog"; r.URL.Path != want {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, want)
+		}
+		startAt := r.URL.Query().Get("startAt")
+		if startAt == "" {
+			startAt = "0"
+		}
+		page, ok := pages[startAt]
+		if !ok {
+			t.Errorf("unexpected startAt %q", startAt)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+
+	histories, resp, err := client.Issues.GetAllChangelog(context.Background(), "TEST-1")
+	if err != nil {
+		t.Fatalf("GetAllChangelog() error = %v", err)
+	}
+	var ids []string
+	for _, h := range histories {
+		ids = append(ids, h.ID)
+	}
+	if want := []string{"1", "2", "3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("GetAllChangelog() IDs = %v, want %v", ids, want)
+	}
+	if !resp.IsLast {
+		t.Errorf("IsLast = %v, want true", resp.IsLast)
+	}
+
+	ids = nil
+	for h, err := range client.Issues.StreamChangelog(context.Background(), "TEST-1") {
+		if err != nil {
+			t.Fatalf("StreamChangelog() error = %v", err)
+		}
+		ids = append(ids, h.ID)
+	}
+	if want := []string{"1", "2", "3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("StreamChangelog() IDs = %v, want %v", ids, want)
+	}
+}
+
+func TestIssuesService_BulkFetchChangelogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Method = %v, want %v", r.Method, http.MethodPost)
+		}
+		if want := "/rest/api/3/changelog/bulkfetch"; r.URL.Path != want {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, want)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if got, want := strings.TrimSpace(string(body)), `{"issueIdsOrKeys":["TEST-1","TEST-2"],"fieldIds":["status"],"maxResults":50}`; got != want {
+			t.Errorf("body = %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"issueChangeLogs":[{"issueId":"10001","changeHistories":[{"id":"1","items":[{"fieldId":"status","toString":"Done"}]}]}],"nextPageToken":"abc"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, _, err := client.Issues.BulkFetchChangelogs(context.Background(), &ChangelogBulkFetchRequest{
+		IssueIDsOrKeys: []string{"TEST-1", "TEST-2"},
+		FieldIDs:       []string{"status"},
+		MaxResults:     50,
+	})
+	if err != nil {
+		t.Fatalf("BulkFetchChangelogs() error = %v", err)
+	}
+	if result.NextPageToken != "abc" {
+		t.Errorf("NextPageToken = %v, want %v", result.NextPageToken, "abc")
+	}
+	if len(result.IssueChangelogs) != 1 || result.IssueChangelogs[0].IssueID != "10001" ||
+		result.IssueChangelogs[0].ChangeHistories[0].Items[0].ToString != "Done" {
+		t.Errorf("IssueChangelogs = %+v", result.IssueChangelogs)
+	}
+}
+
+func TestIssuesService_Notify(t *testing.T) {
+	var got string
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if want := "/rest/api/3/issue/TEST-1/notify"; r.URL.Path != want {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, want)
+		}
+		body, _ := io.ReadAll(r.Body)
+		got = strings.TrimSpace(string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+
+	body, err := adf.NewDocument().Paragraph("Build failed.").Bullet("unit", "lint").Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	_, err = client.Issues.Notify(ctx, "TEST-1", &Notification{
+		Subject: "CI",
+		Body:    body,
+		To:      &NotificationRecipients{Watchers: true},
+	})
+	if err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if want := `{"subject":"CI","textBody":"Build failed.\n- unit\n- lint",` +
+		`"htmlBody":"<p>Build failed.</p><ul><li><p>unit</p></li><li><p>lint</p></li></ul>","to":{"watchers":true}}`; got != want {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+
+	_, err = client.Issues.Notify(ctx, "TEST-1", &Notification{Subject: "CI", TextBody: "x", HTMLBody: "<p>x</p>", Body: body, To: &NotificationRecipients{Assignee: true}})
+	if err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if want := `{"subject":"CI","textBody":"x","htmlBody":"<p>x</p>","to":{"assignee":true}}`; got != want {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+
+	for _, n := range []*Notification{nil, {Subject: "CI"}, {Subject: "CI", To: &NotificationRecipients{Users: []*User{}}}} {
+		if _, err := client.Issues.Notify(ctx, "TEST-1", n); err == nil {
+			t.Errorf("Notify(%+v) error = nil, want error", n)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("requests = %v, want 2", requests)
+	}
+}
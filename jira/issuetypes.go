@@ -130,24 +130,10 @@ func (s *IssueTypesService) GetAlternatives(ctx context.Context, issueTypeID str
 	return issueTypes, resp, nil
 }
 
-// LoadAvatar loads an avatar for an issue type.
+// LoadAvatar loads an avatar for an issue type. It is the same as
+// AvatarsService.LoadIssueTypeAvatar; filename is not sent to Jira.
 func (s *IssueTypesService) LoadAvatar(ctx context.Context, issueTypeID string, x, y, size int, filename string, avatarData []byte) (*Avatar, *Response, error) {
-	u := fmt.Sprintf("/rest/api/3/issuetype/%s/avatar2?x=%d&y=%d&size=%d", issueTypeID, x, y, size)
-
-	req, err := s.client.NewRequest(ctx, http.MethodPost, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-	req.Header.Set("Content-Type", "image/png")
-	req.Header.Set("X-Atlassian-Token", "no-check")
-
-	avatar := new(Avatar)
-	resp, err := s.client.Do(req, avatar)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	return avatar, resp, nil
+	return s.client.Avatars.LoadIssueTypeAvatar(ctx, issueTypeID, x, y, size, avatarData)
 }
 
 // IssueTypeScheme represents an issue type scheme.
@@ -343,8 +329,14 @@ func (s *IssueTypesService) ListProjectsForScheme(ctx context.Context, schemeID
 	return result, resp, nil
 }
 
-// AssignSchemeToProject assigns an issue type scheme to a project.
+// AssignSchemeToProject assigns an issue type scheme to a project. Team-managed
+// projects don't use issue type schemes, so for those it returns a
+// *TeamManagedProjectError without making the change.
 func (s *IssueTypesService) AssignSchemeToProject(ctx context.Context, schemeID int64, projectID string) (*Response, error) {
+	if resp, err := s.client.Projects.requireCompanyManaged(ctx, projectID, "issue type scheme assignment"); err != nil {
+		return resp, err
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodPut, "/rest/api/3/issuetypescheme/project", map[string]interface{}{
 		"issueTypeSchemeId": strconv.FormatInt(schemeID, 10),
 		"projectId":         projectID,
@@ -0,0 +1,42 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIssueTypesService_LoadAvatar(t *testing.T) {
+	jpeg := append([]byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00"), make([]byte, 2048)...)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issuetype/10001/avatar2" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/issuetype/10001/avatar2")
+		}
+		if got := r.Header.Get("Content-Type"); got != "image/jpeg" {
+			t.Errorf("Content-Type = %v, want %v", got, "image/jpeg")
+		}
+		if got := r.Header.Get("X-Atlassian-Token"); got != "no-check" {
+			t.Errorf("X-Atlassian-Token = %v, want %v", got, "no-check")
+		}
+		body, _ := io.ReadAll(r.Body)
+		if len(body) != len(jpeg) {
+			t.Errorf("len(body) = %v, want %v", len(body), len(jpeg))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"10800"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	avatar, _, err := client.IssueTypes.LoadAvatar(context.Background(), "10001", 0, 0, 64, "story.jpg", jpeg)
+	if err != nil {
+		t.Fatalf("LoadAvatar() error = %v", err)
+	}
+	if avatar.ID != "10800" {
+		t.Errorf("ID = %v, want %v", avatar.ID, "10800")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
 // JQLService handles JQL operations for the Jira API.
@@ -482,3 +483,44 @@ func (s *JQLService) ValidateJQL(ctx context.Context, jql string) (bool, []strin
 
 	return true, nil, resp, nil
 }
+
+// The following helpers build calls to JQL's relative date functions for use
+// in queries such as "created >= " + StartOfWeek("-1"). The offset is an
+// increment like "-1", "+2d" or "-3w"; pass an empty offset to call the
+// function with no argument. Function calls must not be quoted in JQL, so use
+// the returned string as is.
+
+// StartOfDay returns a startOfDay() function call.
+func StartOfDay(offset string) string { return jqlDateFunc("startOfDay", offset) }
+
+// EndOfDay returns an endOfDay() function call.
+func EndOfDay(offset string) string { return jqlDateFunc("endOfDay", offset) }
+
+// StartOfWeek returns a startOfWeek() function call.
+func StartOfWeek(offset string) string { return jqlDateFunc("startOfWeek", offset) }
+
+// EndOfWeek returns an endOfWeek() function call.
+func EndOfWeek(offset string) string { return jqlDateFunc("endOfWeek", offset) }
+
+// StartOfMonth returns a startOfMonth() function call.
+func StartOfMonth(offset string) string { return jqlDateFunc("startOfMonth", offset) }
+
+// EndOfMonth returns an endOfMonth() function call.
+func EndOfMonth(offset string) string { return jqlDateFunc("endOfMonth", offset) }
+
+// StartOfYear returns a startOfYear() function call.
+func StartOfYear(offset string) string { return jqlDateFunc("startOfYear", offset) }
+
+// EndOfYear returns an endOfYear() function call.
+func EndOfYear(offset string) string { return jqlDateFunc("endOfYear", offset) }
+
+// Now returns a now() function call. now() takes no offset; for a time
+// relative to now, compare against a bare duration such as "-3d" instead.
+func Now() string { return "now()" }
+
+func jqlDateFunc(name, offset string) string {
+	if offset == "" {
+		return name + "()"
+	}
+	return fmt.Sprintf("%s(%s)", name, strconv.Quote(offset))
+}
@@ -0,0 +1,20 @@
+package jira
+
+import "testing"
+
+func TestJQLDateFunctions(t *testing.T) {
+	tests := []struct {
+		got, want string
+	}{
+		{StartOfDay(""), "startOfDay()"},
+		{StartOfDay("-1"), `startOfDay("-1")`},
+		{EndOfWeek("+2w"), `endOfWeek("+2w")`},
+		{StartOfMonth("-1M"), `startOfMonth("-1M")`},
+		{Now(), "now()"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %v, want %v", tt.got, tt.want)
+		}
+	}
+}
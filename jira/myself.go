@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
@@ -41,7 +42,7 @@ type CurrentUserPreferences struct {
 
 // GetPreference returns a preference for the current user.
 func (s *MyselfService) GetPreference(ctx context.Context, key string) (string, *Response, error) {
-	u := fmt.Sprintf("/rest/api/3/mypreferences?key=%s", key)
+	u := fmt.Sprintf("/rest/api/3/mypreferences?key=%s", url.QueryEscape(key))
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
@@ -59,7 +60,7 @@ func (s *MyselfService) GetPreference(ctx context.Context, key string) (string,
 
 // SetPreference sets a preference for the current user.
 func (s *MyselfService) SetPreference(ctx context.Context, key, value string) (*Response, error) {
-	u := fmt.Sprintf("/rest/api/3/mypreferences?key=%s", key)
+	u := fmt.Sprintf("/rest/api/3/mypreferences?key=%s", url.QueryEscape(key))
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, u, value)
 	if err != nil {
@@ -71,7 +72,7 @@ func (s *MyselfService) SetPreference(ctx context.Context, key, value string) (*
 
 // DeletePreference removes a preference for the current user.
 func (s *MyselfService) DeletePreference(ctx context.Context, key string) (*Response, error) {
-	u := fmt.Sprintf("/rest/api/3/mypreferences?key=%s", key)
+	u := fmt.Sprintf("/rest/api/3/mypreferences?key=%s", url.QueryEscape(key))
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
 	if err != nil {
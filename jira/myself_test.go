@@ -0,0 +1,98 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMyselfService_Get(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/myself" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/myself")
+		}
+		if got := r.URL.Query().Get("expand"); got != "groups,applicationRoles" {
+			t.Errorf("expand = %v, want %v", got, "groups,applicationRoles")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"accountId":"557058:abc","timeZone":"Europe/Berlin","locale":"de_DE"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	user, _, err := client.Myself.Get(context.Background(), []string{"groups", "applicationRoles"})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if user.TimeZone != "Europe/Berlin" || user.Locale != "de_DE" {
+		t.Errorf("TimeZone, Locale = %v, %v, want Europe/Berlin, de_DE", user.TimeZone, user.Locale)
+	}
+}
+
+func TestMyselfService_Preferences(t *testing.T) {
+	const key = "user.notify.own&changes"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/mypreferences" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/mypreferences")
+		}
+		if got := r.URL.Query()["key"]; len(got) != 1 || got[0] != key {
+			t.Errorf("key = %v, want [%v]", got, key)
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`"false"`))
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if got := strings.TrimSpace(string(body)); got != `"true"` {
+				t.Errorf("body = %v, want %v", got, `"true"`)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+
+	value, _, err := client.Myself.GetPreference(ctx, key)
+	if err != nil {
+		t.Fatalf("GetPreference() error = %v", err)
+	}
+	if value != "false" {
+		t.Errorf("GetPreference() = %v, want %v", value, "false")
+	}
+	if _, err := client.Myself.SetPreference(ctx, key, "true"); err != nil {
+		t.Errorf("SetPreference() error = %v", err)
+	}
+	if _, err := client.Myself.DeletePreference(ctx, key); err != nil {
+		t.Errorf("DeletePreference() error = %v", err)
+	}
+}
+
+func TestMyselfService_GetLocale(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/mypreferences/locale" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/mypreferences/locale")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"locale":"en_US"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	prefs, _, err := client.Myself.GetLocale(context.Background())
+	if err != nil {
+		t.Fatalf("GetLocale() error = %v", err)
+	}
+	if prefs.Locale != "en_US" {
+		t.Errorf("Locale = %v, want %v", prefs.Locale, "en_US")
+	}
+}
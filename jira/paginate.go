@@ -0,0 +1,60 @@
+package jira
+
+import (
+	"context"
+	"iter"
+)
+
+// PageFunc fetches the page of results starting at startAt.
+type PageFunc[T any] func(startAt int) ([]T, *Response, error)
+
+// Paginate returns an iterator over every result of a paginated endpoint,
+// calling fetch for each page as the loop advances. Paging stops when the
+// response reports the last page, when startAt reaches the response's total,
+// or when a page comes back empty. A response without paging values is
+// treated as a single page. Iteration stops after the first error, which is
+// yielded with the zero value, and also when ctx is done.
+//
+//	pages := jira.Paginate(ctx, func(startAt int) ([]*jira.Project, *jira.Response, error) {
+//		result, resp, err := client.Projects.List(ctx, &jira.ProjectListOptions{StartAt: startAt})
+//		if err != nil {
+//			return nil, resp, err
+//		}
+//		return result.Values, resp, nil
+//	})
+//	for project, err := range pages {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(project.Key)
+//	}
+func Paginate[T any](ctx context.Context, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		startAt := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			values, resp, err := fetch(startAt)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, v := range values {
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			startAt += len(values)
+			if len(values) == 0 || resp == nil || resp.IsLast ||
+				(resp.Total > 0 && startAt >= resp.Total) ||
+				(resp.MaxResults == 0 && resp.Total == 0) {
+				return
+			}
+		}
+	}
+}
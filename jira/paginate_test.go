@@ -0,0 +1,130 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// pagedProjectServer serves total projects from /rest/api/3/project/search,
+// pageSize at a time, setting isLast on the final page only if withIsLast.
+func pagedProjectServer(total, pageSize int, withIsLast bool, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		result := ProjectListResult{StartAt: startAt, MaxResults: pageSize, Total: total}
+		for i := startAt; i < total && i < startAt+pageSize; i++ {
+			result.Values = append(result.Values, &Project{Key: "P" + strconv.Itoa(i)})
+		}
+		result.IsLast = withIsLast && startAt+pageSize >= total
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}))
+}
+
+func listProjects(ctx context.Context, client *Client) PageFunc[*Project] {
+	return func(startAt int) ([]*Project, *Response, error) {
+		result, resp, err := client.Projects.List(ctx, &ProjectListOptions{StartAt: startAt})
+		if err != nil {
+			return nil, resp, err
+		}
+		return result.Values, resp, nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name       string
+		withIsLast bool
+	}{
+		{"isLast", true},
+		{"total", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := pagedProjectServer(5, 2, tt.withIsLast, &requests)
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			ctx := context.Background()
+			var keys []string
+			for project, err := range Paginate(ctx, listProjects(ctx, client)) {
+				if err != nil {
+					t.Fatalf("Paginate() error = %v", err)
+				}
+				keys = append(keys, project.Key)
+			}
+			if len(keys) != 5 || keys[0] != "P0" || keys[4] != "P4" {
+				t.Errorf("keys = %v, want [P0 P1 P2 P3 P4]", keys)
+			}
+			if requests != 3 {
+				t.Errorf("requests = %v, want 3", requests)
+			}
+		})
+	}
+}
+
+func TestPaginate_Break(t *testing.T) {
+	requests := 0
+	server := pagedProjectServer(10, 2, true, &requests)
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+	count := 0
+	for _, err := range Paginate(ctx, listProjects(ctx, client)) {
+		if err != nil {
+			t.Fatalf("Paginate() error = %v", err)
+		}
+		if count++; count == 3 {
+			break
+		}
+	}
+	if requests != 2 {
+		t.Errorf("requests = %v, want 2", requests)
+	}
+}
+
+func TestPaginate_Error(t *testing.T) {
+	wantErr := errors.New("boom")
+	calls := 0
+	fetch := func(startAt int) ([]int, *Response, error) {
+		calls++
+		if startAt > 0 {
+			return nil, nil, wantErr
+		}
+		return []int{1, 2}, &Response{MaxResults: 2, Total: 4}, nil
+	}
+
+	var got []int
+	var gotErr error
+	for v, err := range Paginate(context.Background(), fetch) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, v)
+	}
+	if len(got) != 2 || !errors.Is(gotErr, wantErr) || calls != 2 {
+		t.Errorf("Paginate() = %v, %v after %d calls, want [1 2], %v after 2", got, gotErr, calls, wantErr)
+	}
+}
+
+func TestPaginate_SinglePage(t *testing.T) {
+	calls := 0
+	fetch := func(startAt int) ([]string, *Response, error) {
+		calls++
+		return []string{"a", "b"}, &Response{}, nil
+	}
+	for range Paginate(context.Background(), fetch) {
+	}
+	if calls != 1 {
+		t.Errorf("calls = %v, want 1", calls)
+	}
+}
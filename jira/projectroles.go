@@ -207,18 +207,35 @@ func (s *ProjectRolesService) GetRoleDetails(ctx context.Context, projectIDOrKey
 	return roles, resp, nil
 }
 
-// ActorRequest represents actors to add/set for a role.
+// ActorRequest represents actors to add/set for a role. User holds account
+// IDs; groups are given by name in Group or, preferably, by ID in GroupID.
 type ActorRequest struct {
-	User  []string `json:"user,omitempty"`
-	Group []string `json:"group,omitempty"`
+	User    []string `json:"user,omitempty"`
+	Group   []string `json:"group,omitempty"`
+	GroupID []string `json:"groupId,omitempty"`
 }
 
-// SetActors sets actors for a project role.
+// SetActors replaces the actors of a project role with actors.
 func (s *ProjectRolesService) SetActors(ctx context.Context, projectIDOrKey string, roleID int64, actors *ActorRequest) (*ProjectRole, *Response, error) {
 	u := fmt.Sprintf("/rest/api/3/project/%s/role/%d", projectIDOrKey, roleID)
 
-	req, err := s.client.NewRequest(ctx, http.MethodPut, u, map[string]interface{}{
-		"categorisedActors": actors,
+	// The replace endpoint keys actors by role actor type rather than by the
+	// field names AddActors uses.
+	categorised := map[string][]string{}
+	if actors != nil {
+		if len(actors.User) > 0 {
+			categorised["atlassian-user-role-actor"] = actors.User
+		}
+		if len(actors.Group) > 0 {
+			categorised["atlassian-group-role-actor"] = actors.Group
+		}
+		if len(actors.GroupID) > 0 {
+			categorised["atlassian-group-role-actor-id"] = actors.GroupID
+		}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, map[string]any{
+		"categorisedActors": categorised,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -251,7 +268,8 @@ func (s *ProjectRolesService) AddActors(ctx context.Context, projectIDOrKey stri
 	return role, resp, nil
 }
 
-// RemoveActor removes an actor from a project role.
+// RemoveActor removes an actor from a project role: the user with account ID
+// user, or the group named group.
 func (s *ProjectRolesService) RemoveActor(ctx context.Context, projectIDOrKey string, roleID int64, user, group string) (*Response, error) {
 	u := fmt.Sprintf("/rest/api/3/project/%s/role/%d", projectIDOrKey, roleID)
 
@@ -0,0 +1,128 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestProjectRolesService_Actors(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(*Client) error
+		wantMethod string
+		wantQuery  string
+		wantBody   string
+	}{
+		{
+			name: "AddActors",
+			call: func(c *Client) error {
+				_, _, err := c.ProjectRoles.AddActors(context.Background(), "TEST", 10002, &ActorRequest{
+					User:    []string{"557058:abc"},
+					GroupID: []string{"g-1"},
+				})
+				return err
+			},
+			wantMethod: http.MethodPost,
+			wantBody:   `{"user":["557058:abc"],"groupId":["g-1"]}`,
+		},
+		{
+			name: "SetActors",
+			call: func(c *Client) error {
+				_, _, err := c.ProjectRoles.SetActors(context.Background(), "TEST", 10002, &ActorRequest{
+					User:  []string{"557058:abc"},
+					Group: []string{"jira-developers"},
+				})
+				return err
+			},
+			wantMethod: http.MethodPut,
+			wantBody: `{"categorisedActors":{"atlassian-group-role-actor":["jira-developers"],` +
+				`"atlassian-user-role-actor":["557058:abc"]}}`,
+		},
+		{
+			name: "RemoveActor user",
+			call: func(c *Client) error {
+				_, err := c.ProjectRoles.RemoveActor(context.Background(), "TEST", 10002, "557058:abc", "")
+				return err
+			},
+			wantMethod: http.MethodDelete,
+			wantQuery:  "user=557058%3Aabc",
+		},
+		{
+			name: "RemoveActor group",
+			call: func(c *Client) error {
+				_, err := c.ProjectRoles.RemoveActor(context.Background(), "TEST", 10002, "", "jira developers")
+				return err
+			},
+			wantMethod: http.MethodDelete,
+			wantQuery:  "group=jira+developers",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.wantMethod {
+					t.Errorf("Method = %v, want %v", r.Method, tt.wantMethod)
+				}
+				if r.URL.Path != "/rest/api/3/project/TEST/role/10002" {
+					t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/project/TEST/role/10002")
+				}
+				if r.URL.RawQuery != tt.wantQuery {
+					t.Errorf("query = %v, want %v", r.URL.RawQuery, tt.wantQuery)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body = %v, want %v", got, tt.wantBody)
+				}
+
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":10002,"name":"Developers","actors":[{"id":1,"type":"atlassian-user-role-actor","actorUser":{"accountId":"557058:abc"}}]}`))
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			if err := tt.call(client); err != nil {
+				t.Errorf("%s() error = %v", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestProjectRolesService_GetForProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/project/TEST/role/10002" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/project/TEST/role/10002")
+		}
+		if got := r.URL.Query().Get("excludeInactiveUsers"); got != "true" {
+			t.Errorf("excludeInactiveUsers = %v, want %v", got, "true")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":10002,"name":"Developers","actors":[` +
+			`{"id":1,"type":"atlassian-user-role-actor","actorUser":{"accountId":"557058:abc"}},` +
+			`{"id":2,"type":"atlassian-group-role-actor","actorGroup":{"name":"jira-developers","groupId":"g-1"}}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	role, _, err := client.ProjectRoles.GetForProject(context.Background(), "TEST", 10002, true)
+	if err != nil {
+		t.Fatalf("GetForProject() error = %v", err)
+	}
+	if len(role.Actors) != 2 {
+		t.Fatalf("len(Actors) = %v, want 2", len(role.Actors))
+	}
+	if role.Actors[0].ActorUser == nil || role.Actors[0].ActorUser.AccountID != "557058:abc" {
+		t.Errorf("Actors[0] = %+v, want user 557058:abc", role.Actors[0])
+	}
+	if role.Actors[1].ActorGroup == nil || role.Actors[1].ActorGroup.GroupID != "g-1" {
+		t.Errorf("Actors[1] = %+v, want group g-1", role.Actors[1])
+	}
+}
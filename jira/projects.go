@@ -170,6 +170,106 @@ func (s *ProjectsService) Get(ctx context.Context, projectIDOrKey string, opts *
 	return project, resp, nil
 }
 
+// Project type keys for ProjectCreateRequest.ProjectTypeKey.
+const (
+	ProjectTypeSoftware    = "software"
+	ProjectTypeBusiness    = "business"
+	ProjectTypeServiceDesk = "service_desk"
+)
+
+// Project styles reported in Project.Style.
+const (
+	// ProjectStyleClassic is a company-managed project.
+	ProjectStyleClassic = "classic"
+	// ProjectStyleNextGen is a team-managed project.
+	ProjectStyleNextGen = "next-gen"
+)
+
+// IsTeamManaged reports whether the project is team-managed (formerly
+// next-gen). Team-managed projects configure issue types, fields and
+// workflows per project, so scheme-based admin operations don't apply.
+func (p *Project) IsTeamManaged() bool {
+	return p.Simplified || p.Style == ProjectStyleNextGen
+}
+
+// TeamManagedProjectError is returned when an operation that only applies to
+// company-managed projects is attempted on a team-managed project.
+type TeamManagedProjectError struct {
+	Project   string
+	Operation string
+}
+
+// Error implements the error interface.
+func (e *TeamManagedProjectError) Error() string {
+	return fmt.Sprintf("%s is not supported for team-managed project %s", e.Operation, e.Project)
+}
+
+// requireCompanyManaged fetches a project and returns a
+// *TeamManagedProjectError if it is team-managed.
+func (s *ProjectsService) requireCompanyManaged(ctx context.Context, projectIDOrKey, operation string) (*Response, error) {
+	project, resp, err := s.Get(ctx, projectIDOrKey, nil)
+	if err != nil {
+		return resp, err
+	}
+	if project.IsTeamManaged() {
+		return resp, &TeamManagedProjectError{Project: projectIDOrKey, Operation: operation}
+	}
+	return resp, nil
+}
+
+// Project template keys for ProjectCreateRequest.ProjectTemplateKey. Each
+// template belongs to one project type, noted in brackets.
+const (
+	// ProjectTemplateScrum is a team-managed Scrum project [software].
+	ProjectTemplateScrum = "com.pyxis.greenhopper.jira:gh-simplified-agility-scrum"
+	// ProjectTemplateKanban is a team-managed Kanban project [software].
+	ProjectTemplateKanban = "com.pyxis.greenhopper.jira:gh-simplified-agility-kanban"
+	// ProjectTemplateBasicSoftware is a company-managed basic software project [software].
+	ProjectTemplateBasicSoftware = "com.pyxis.greenhopper.jira:gh-simplified-basic"
+	// ProjectTemplateScrumClassic is a company-managed Scrum project [software].
+	ProjectTemplateScrumClassic = "com.pyxis.greenhopper.jira:gh-simplified-scrum-classic"
+	// ProjectTemplateKanbanClassic is a company-managed Kanban project [software].
+	ProjectTemplateKanbanClassic = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+
+	// ProjectTemplateProjectManagement is a project management project [business].
+	ProjectTemplateProjectManagement = "com.atlassian.jira-core-project-templates:jira-core-simplified-project-management"
+	// ProjectTemplateTaskTracking is a task tracking project [business].
+	ProjectTemplateTaskTracking = "com.atlassian.jira-core-project-templates:jira-core-simplified-task-tracking"
+	// ProjectTemplateProcessControl is a process control project [business].
+	ProjectTemplateProcessControl = "com.atlassian.jira-core-project-templates:jira-core-simplified-process-control"
+
+	// ProjectTemplateITServiceManagement is an IT service management project [service_desk].
+	ProjectTemplateITServiceManagement = "com.atlassian.servicedesk:simplified-it-service-management"
+	// ProjectTemplateGeneralServiceDesk is a general service project [service_desk].
+	ProjectTemplateGeneralServiceDesk = "com.atlassian.servicedesk:simplified-general-service-desk"
+	// ProjectTemplateInternalServiceDesk is an internal service project [service_desk].
+	ProjectTemplateInternalServiceDesk = "com.atlassian.servicedesk:simplified-internal-service-desk"
+	// ProjectTemplateExternalServiceDesk is a customer service project [service_desk].
+	ProjectTemplateExternalServiceDesk = "com.atlassian.servicedesk:simplified-external-service-desk"
+)
+
+// projectTemplatePrefixes maps template key prefixes to the project type they
+// create. Templates from other vendors are not checked.
+var projectTemplatePrefixes = map[string]string{
+	"com.pyxis.greenhopper.jira:":                ProjectTypeSoftware,
+	"com.atlassian.jira-core-project-templates:": ProjectTypeBusiness,
+	"com.atlassian.servicedesk:":                 ProjectTypeServiceDesk,
+}
+
+// validateProjectTemplate returns an error if templateKey is a known Atlassian
+// template that does not belong to typeKey.
+func validateProjectTemplate(typeKey, templateKey string) error {
+	if typeKey == "" || templateKey == "" {
+		return nil
+	}
+	for prefix, want := range projectTemplatePrefixes {
+		if strings.HasPrefix(templateKey, prefix) && typeKey != want {
+			return fmt.Errorf("project template %q requires project type %q, not %q", templateKey, want, typeKey)
+		}
+	}
+	return nil
+}
+
 // ProjectCreateRequest represents a request to create a project.
 type ProjectCreateRequest struct {
 	Key                      string `json:"key"`
@@ -200,7 +300,16 @@ type ProjectCreateResponse struct {
 }
 
 // Create creates a new project.
+//
+// If both ProjectTypeKey and ProjectTemplateKey are set, Create checks that
+// the template belongs to the project type before sending the request.
 func (s *ProjectsService) Create(ctx context.Context, project *ProjectCreateRequest) (*ProjectCreateResponse, *Response, error) {
+	if project != nil {
+		if err := validateProjectTemplate(project.ProjectTypeKey, project.ProjectTemplateKey); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodPost, "/rest/api/3/project", project)
 	if err != nil {
 		return nil, nil, err
@@ -497,3 +606,81 @@ func (s *ProjectsService) GetSecurityLevels(ctx context.Context, projectKeyOrID
 type ProjectIssueSecurityLevels struct {
 	Levels []*SecurityLevel `json:"levels,omitempty"`
 }
+
+// ListPropertyKeys returns the keys of a project's properties.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-properties/#api-rest-api-3-project-projectidorkey-properties-get
+func (s *ProjectsService) ListPropertyKeys(ctx context.Context, projectKeyOrID string) ([]string, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/project/%s/properties", projectKeyOrID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Keys []struct {
+			Key string `json:"key"`
+		} `json:"keys"`
+	}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	keys := make([]string, len(result.Keys))
+	for i, k := range result.Keys {
+		keys[i] = k.Key
+	}
+
+	return keys, resp, nil
+}
+
+// GetProperty returns a project property.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-properties/#api-rest-api-3-project-projectidorkey-properties-propertykey-get
+func (s *ProjectsService) GetProperty(ctx context.Context, projectKeyOrID, propertyKey string) (*EntityProperty, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/project/%s/properties/%s", projectKeyOrID, url.PathEscape(propertyKey))
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prop := new(EntityProperty)
+	resp, err := s.client.Do(req, prop)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return prop, resp, nil
+}
+
+// SetProperty sets a project property. value is sent as the request body
+// as is, and becomes the property's value.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-properties/#api-rest-api-3-project-projectidorkey-properties-propertykey-put
+func (s *ProjectsService) SetProperty(ctx context.Context, projectKeyOrID, propertyKey string, value any) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/project/%s/properties/%s", projectKeyOrID, url.PathEscape(propertyKey))
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteProperty deletes a project property.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-properties/#api-rest-api-3-project-projectidorkey-properties-propertykey-delete
+func (s *ProjectsService) DeleteProperty(ctx context.Context, projectKeyOrID, propertyKey string) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/project/%s/properties/%s", projectKeyOrID, url.PathEscape(propertyKey))
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -3,8 +3,11 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -115,6 +118,42 @@ func TestProjectsService_Create(t *testing.T) {
 	}
 }
 
+func TestProjectsService_Create_TemplateMismatch(t *testing.T) {
+	client, _ := NewClient("https://example.atlassian.net")
+	_, _, err := client.Projects.Create(context.Background(), &ProjectCreateRequest{
+		Key:                "NEW",
+		Name:               "New Project",
+		ProjectTypeKey:     ProjectTypeBusiness,
+		ProjectTemplateKey: ProjectTemplateScrum,
+		LeadAccountID:      "123",
+	})
+	if err == nil {
+		t.Error("Create() expected error for mismatched project type and template")
+	}
+}
+
+func TestValidateProjectTemplate(t *testing.T) {
+	tests := []struct {
+		typeKey     string
+		templateKey string
+		wantErr     bool
+	}{
+		{ProjectTypeSoftware, ProjectTemplateKanban, false},
+		{ProjectTypeBusiness, ProjectTemplateTaskTracking, false},
+		{ProjectTypeServiceDesk, ProjectTemplateITServiceManagement, false},
+		{ProjectTypeServiceDesk, ProjectTemplateScrumClassic, true},
+		{ProjectTypeSoftware, "com.example:custom-template", false},
+		{"", ProjectTemplateScrum, false},
+	}
+
+	for _, tt := range tests {
+		err := validateProjectTemplate(tt.typeKey, tt.templateKey)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateProjectTemplate(%q, %q) error = %v, wantErr %v", tt.typeKey, tt.templateKey, err, tt.wantErr)
+		}
+	}
+}
+
 func TestProjectsService_Update(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPut {
@@ -217,3 +256,108 @@ func TestProjectsService_ListRecent(t *testing.T) {
 		t.Errorf("len(projects) = %v, want %v", len(projects), 2)
 	}
 }
+
+func TestProject_IsTeamManaged(t *testing.T) {
+	if !(&Project{Simplified: true}).IsTeamManaged() {
+		t.Error("IsTeamManaged() = false for simplified project")
+	}
+	if !(&Project{Style: ProjectStyleNextGen}).IsTeamManaged() {
+		t.Error("IsTeamManaged() = false for next-gen project")
+	}
+	if (&Project{Style: ProjectStyleClassic}).IsTeamManaged() {
+		t.Error("IsTeamManaged() = true for classic project")
+	}
+}
+
+func TestWorkflowSchemesService_AssignToProject_TeamManaged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Project{ID: "10001", Style: ProjectStyleNextGen, Simplified: true})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	_, err := client.WorkflowSchemes.AssignToProject(context.Background(), 10032, "10001")
+	var tmErr *TeamManagedProjectError
+	if !errors.As(err, &tmErr) {
+		t.Fatalf("AssignToProject() error = %v, want *TeamManagedProjectError", err)
+	}
+}
+
+func TestIssueTypesService_AssignSchemeToProject(t *testing.T) {
+	var assigned bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/project/10001":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(Project{ID: "10001", Style: ProjectStyleClassic})
+		case r.Method == http.MethodPut && r.URL.Path == "/rest/api/3/issuetypescheme/project":
+			assigned = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	if _, err := client.IssueTypes.AssignSchemeToProject(context.Background(), 10000, "10001"); err != nil {
+		t.Fatalf("AssignSchemeToProject() error = %v", err)
+	}
+	if !assigned {
+		t.Error("AssignSchemeToProject() did not assign the scheme")
+	}
+}
+
+func TestProjectsService_Properties(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /rest/api/3/project/TEST/properties":
+			w.Write([]byte(`{"keys":[{"key":"config","self":"x"},{"key":"owner","self":"y"}]}`))
+		case "GET /rest/api/3/project/TEST/properties/config":
+			w.Write([]byte(`{"key":"config","value":{"enabled":true}}`))
+		case "PUT /rest/api/3/project/TEST/properties/config":
+			body, _ := io.ReadAll(r.Body)
+			if got, want := strings.TrimSpace(string(body)), `{"enabled":true}`; got != want {
+				t.Errorf("body = %v, want %v", got, want)
+			}
+			w.WriteHeader(http.StatusOK)
+		case "DELETE /rest/api/3/project/TEST/properties/config":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+
+	keys, _, err := client.Projects.ListPropertyKeys(ctx, "TEST")
+	if err != nil {
+		t.Fatalf("ListPropertyKeys() error = %v", err)
+	}
+	if len(keys) != 2 || keys[0] != "config" || keys[1] != "owner" {
+		t.Errorf("keys = %v, want [config owner]", keys)
+	}
+
+	prop, _, err := client.Projects.GetProperty(ctx, "TEST", "config")
+	if err != nil {
+		t.Fatalf("GetProperty() error = %v", err)
+	}
+	if prop.Key != "config" {
+		t.Errorf("Key = %v, want %v", prop.Key, "config")
+	}
+
+	if _, err := client.Projects.SetProperty(ctx, "TEST", "config", map[string]bool{"enabled": true}); err != nil {
+		t.Errorf("SetProperty() error = %v", err)
+	}
+	if _, err := client.Projects.DeleteProperty(ctx, "TEST", "config"); err != nil {
+		t.Errorf("DeleteProperty() error = %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestResolutionsService_Requests(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(*Client) (*Response, error)
+		wantMethod string
+		wantPath   string
+		wantQuery  string
+		wantBody   string
+	}{
+		{
+			name: "SetDefault",
+			call: func(c *Client) (*Response, error) {
+				return c.Resolutions.SetDefault(context.Background(), "10001")
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/rest/api/3/resolution/default",
+			wantBody:   `{"id":"10001"}`,
+		},
+		{
+			name: "Move first",
+			call: func(c *Client) (*Response, error) {
+				return c.Resolutions.Move(context.Background(), []string{"10002", "10003"}, "First", "")
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/rest/api/3/resolution/move",
+			wantBody:   `{"ids":["10002","10003"],"position":"First"}`,
+		},
+		{
+			name: "Move after",
+			call: func(c *Client) (*Response, error) {
+				return c.Resolutions.Move(context.Background(), []string{"10002"}, "", "10000")
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/rest/api/3/resolution/move",
+			wantBody:   `{"after":"10000","ids":["10002"]}`,
+		},
+		{
+			name: "Delete",
+			call: func(c *Client) (*Response, error) {
+				return c.Resolutions.Delete(context.Background(), "10004", "10001")
+			},
+			wantMethod: http.MethodDelete,
+			wantPath:   "/rest/api/3/resolution/10004",
+			wantQuery:  "replaceWith=10001",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.wantMethod {
+					t.Errorf("Method = %v, want %v", r.Method, tt.wantMethod)
+				}
+				if r.URL.Path != tt.wantPath {
+					t.Errorf("URL path = %v, want %v", r.URL.Path, tt.wantPath)
+				}
+				if r.URL.RawQuery != tt.wantQuery {
+					t.Errorf("query = %v, want %v", r.URL.RawQuery, tt.wantQuery)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body = %v, want %v", got, tt.wantBody)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			if _, err := tt.call(client); err != nil {
+				t.Errorf("%s() error = %v", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestResolutionsService_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/resolution/search" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/resolution/search")
+		}
+		if want := "id=10000&id=10001&maxResults=2&onlyDefault=true&startAt=2"; r.URL.RawQuery != want {
+			t.Errorf("query = %v, want %v", r.URL.RawQuery, want)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"startAt":2,"maxResults":2,"total":3,"isLast":true,"values":[{"id":"10000","name":"Done","isDefault":true}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, _, err := client.Resolutions.Search(context.Background(), 2, 2, []string{"10000", "10001"}, true)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if !result.IsLast || len(result.Values) != 1 || !result.Values[0].IsDefault {
+		t.Errorf("result = %+v, want the last page with the default resolution", result)
+	}
+}
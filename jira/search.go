@@ -3,9 +3,14 @@ package jira
 import (
 	"context"
 	"fmt"
+	"iter"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // SearchService handles search operations for the Jira API.
@@ -13,6 +18,19 @@ type SearchService struct {
 	client *Client
 }
 
+// JQL validation modes accepted by SearchOptions.ValidateQuery.
+const (
+	// ValidateQueryStrict fails the search if the JQL has any validation errors.
+	ValidateQueryStrict = "strict"
+
+	// ValidateQueryWarn runs the search and returns validation problems, such as
+	// clauses that reference unknown values, in SearchResult.WarningMessages.
+	ValidateQueryWarn = "warn"
+
+	// ValidateQueryNone skips JQL validation.
+	ValidateQueryNone = "none"
+)
+
 // SearchOptions specifies optional parameters for search requests.
 type SearchOptions struct {
 	// Fields to return for each issue.
@@ -30,14 +48,21 @@ type SearchOptions struct {
 	// MaxResults maximum number of results to return.
 	MaxResults int `url:"maxResults,omitempty"`
 
-	// NextPageToken for pagination (Jira API v3 style).
+	// NextPageToken requests the page after the one that returned it in
+	// SearchResult.NextPageToken. Leave it empty for the first page.
 	NextPageToken string `url:"nextPageToken,omitempty"`
 
-	// StartAt index of the first result to return (legacy pagination).
+	// StartAt index of the first result to return. Only Legacy supports it;
+	// Do pages with NextPageToken.
 	StartAt int `url:"startAt,omitempty"`
 
-	// ValidateQuery level of JQL query validation.
+	// ValidateQuery level of JQL query validation. One of ValidateQueryStrict,
+	// ValidateQueryWarn or ValidateQueryNone; the server default is strict.
 	ValidateQuery string `url:"validateQuery,omitempty"`
+
+	// Limit caps the number of issues Stream and DoAll return across all
+	// pages; zero means no cap. It is not sent to Jira.
+	Limit int `url:"-"`
 }
 
 // SearchResult represents the result of a search query.
@@ -47,10 +72,11 @@ type SearchResult struct {
 	MaxResults      int                    `json:"maxResults,omitempty"`
 	Total           int                    `json:"total,omitempty"`
 	Issues          []*Issue               `json:"issues,omitempty"`
-	WarningMessages []string               `json:"warningMessages,omitempty"`
+	WarningMessages []string               `json:"warningMessages,omitempty"` // Populated when ValidateQuery is "warn"
 	Names           map[string]string      `json:"names,omitempty"`
 	Schema          map[string]interface{} `json:"schema,omitempty"`
-	NextPageToken   string                 `json:"nextPageToken,omitempty"`
+	NextPageToken   string                 `json:"nextPageToken,omitempty"` // Empty on the last page
+	IsLast          bool                   `json:"isLast,omitempty"`
 }
 
 // SearchRequest represents a POST search request body.
@@ -67,6 +93,10 @@ type SearchRequest struct {
 
 // Do performs a JQL search using the new v3 endpoint.
 // This is the recommended search method for Jira Cloud.
+//
+// Results are paged by token rather than by offset: pass the returned
+// SearchResult.NextPageToken as SearchOptions.NextPageToken to fetch the next
+// page, until it comes back empty. Stream does this for you.
 func (s *SearchService) Do(ctx context.Context, jql string, opts *SearchOptions) (*SearchResult, *Response, error) {
 	u := "/rest/api/3/search/jql"
 
@@ -93,6 +123,9 @@ func (s *SearchService) Do(ctx context.Context, jql string, opts *SearchOptions)
 				params.Add("expand", e)
 			}
 		}
+		for _, p := range opts.Properties {
+			params.Add("properties", p)
+		}
 		if opts.ValidateQuery != "" {
 			params.Set("validateQuery", opts.ValidateQuery)
 		}
@@ -117,6 +150,185 @@ func (s *SearchService) Do(ctx context.Context, jql string, opts *SearchOptions)
 	return result, resp, nil
 }
 
+// Stream returns an iterator over every issue matching jql, following
+// nextPageToken as the loop advances. opts.NextPageToken, if set, is where
+// iteration starts, opts.MaxResults sets the page size and opts.Limit caps
+// the number of issues. Iteration stops after the first error, which is
+// yielded with a nil issue.
+//
+//	for issue, err := range client.Search.Stream(ctx, "project = PROJ", nil) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(issue.Key)
+//	}
+func (s *SearchService) Stream(ctx context.Context, jql string, opts *SearchOptions) iter.Seq2[*Issue, error] {
+	return func(yield func(*Issue, error) bool) {
+		limit := 0
+		if opts != nil {
+			limit = opts.Limit
+		}
+		count := 0
+		_, err := s.walkPages(ctx, jql, opts, func(result *SearchResult) bool {
+			for _, issue := range result.Issues {
+				if !yield(issue, nil) {
+					return false
+				}
+				if count++; count == limit {
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// walkPages calls fn with each page of the results of a search, following
+// nextPageToken from opts.NextPageToken until the last page or until fn
+// returns false. It returns the Response of the last page fetched, and the
+// error of the page that failed, if any. opts.Limit is left to fn.
+func (s *SearchService) walkPages(ctx context.Context, jql string, opts *SearchOptions, fn func(*SearchResult) bool) (*Response, error) {
+	pageOpts := SearchOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	count := 0
+	for {
+		result, resp, err := s.Do(ctx, jql, &pageOpts)
+		if err != nil {
+			return resp, err
+		}
+		if !fn(result) {
+			return resp, nil
+		}
+		count += len(result.Issues)
+		if result.IsLast || (result.Total > 0 && count >= result.Total) ||
+			result.NextPageToken == "" || result.NextPageToken == pageOpts.NextPageToken {
+			return resp, nil
+		}
+		pageOpts.NextPageToken = result.NextPageToken
+	}
+}
+
+// consistencyInitialDelay and consistencyMaxDelay bound the backoff used by
+// SearchConsistent between attempts.
+var (
+	consistencyInitialDelay = 250 * time.Millisecond
+	consistencyMaxDelay     = 5 * time.Second
+)
+
+// SearchConsistent runs a JQL search until every key in expectKeys appears in
+// the results or maxWait elapses, and returns all matching issues.
+//
+// Jira's search index is eventually consistent: an issue created or updated a
+// moment ago may not match a JQL query yet, although Issues.Get sees it
+// immediately. SearchConsistent retries with exponential backoff to cover that
+// lag, which makes it suitable for tests and workflows that write and then
+// query. When the deadline passes it returns the last results along with an
+// error naming the keys that never appeared.
+func (s *SearchService) SearchConsistent(ctx context.Context, jql string, opts *SearchOptions, expectKeys []string, maxWait time.Duration) ([]*Issue, error) {
+	deadline := time.Now().Add(maxWait)
+	delay := consistencyInitialDelay
+
+	for {
+		issues, err := s.DoAll(ctx, jql, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		found := make(map[string]bool, len(issues))
+		for _, issue := range issues {
+			found[issue.Key] = true
+		}
+		var missing []string
+		for _, key := range expectKeys {
+			if !found[key] {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) == 0 {
+			return issues, nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return issues, fmt.Errorf("search did not return %s within %s", strings.Join(missing, ", "), maxWait)
+		}
+		if delay > remaining {
+			delay = remaining
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return issues, ctx.Err()
+		case <-timer.C:
+		}
+
+		delay *= 2
+		if delay > consistencyMaxDelay {
+			delay = consistencyMaxDelay
+		}
+	}
+}
+
+// DoAll returns every issue matching jql, fetching pages of opts.MaxResults
+// issues until the last one. Set opts.Limit to stop after that many issues,
+// or cancel ctx to stop early. An error from any page is returned at once,
+// without the issues fetched so far.
+func (s *SearchService) DoAll(ctx context.Context, jql string, opts *SearchOptions) ([]*Issue, error) {
+	var issues []*Issue
+	for issue, err := range s.Stream(ctx, jql, opts) {
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+// fieldNameExpands are the expansions DoWithFieldNames adds to a search.
+var fieldNameExpands = []string{"names", "schema", "renderedFields"}
+
+// DoWithFieldNames is like DoAll, but also expands names, schema and
+// renderedFields and returns the display names of the fields in the results,
+// keyed by field ID, so that "customfield_10010" can be shown as "Sprint".
+// The Response is that of the last page fetched.
+func (s *SearchService) DoWithFieldNames(ctx context.Context, jql string, opts *SearchOptions) ([]*Issue, map[string]string, *Response, error) {
+	pageOpts := SearchOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	pageOpts.Expand = slices.Clone(pageOpts.Expand)
+	for _, e := range fieldNameExpands {
+		if !slices.Contains(pageOpts.Expand, e) {
+			pageOpts.Expand = append(pageOpts.Expand, e)
+		}
+	}
+
+	var issues []*Issue
+	names := map[string]string{}
+	resp, err := s.walkPages(ctx, jql, &pageOpts, func(result *SearchResult) bool {
+		maps.Copy(names, result.Names)
+		for _, issue := range result.Issues {
+			maps.Copy(names, issue.Names)
+			issues = append(issues, issue)
+			if len(issues) == pageOpts.Limit {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, nil, resp, err
+	}
+	return issues, names, resp, nil
+}
+
 // DoPost performs a JQL search using POST method.
 // Use this for complex queries that might exceed URL length limits.
 func (s *SearchService) DoPost(ctx context.Context, searchReq *SearchRequest) (*SearchResult, *Response, error) {
@@ -159,6 +371,15 @@ func (s *SearchService) Legacy(ctx context.Context, jql string, opts *SearchOpti
 				params.Add("expand", e)
 			}
 		}
+		for _, p := range opts.Properties {
+			params.Add("properties", p)
+		}
+		if opts.ValidateQuery != "" {
+			params.Set("validateQuery", opts.ValidateQuery)
+		}
+		if opts.FieldsByKeys {
+			params.Set("fieldsByKeys", "true")
+		}
 	}
 
 	u = fmt.Sprintf("%s?%s", u, params.Encode())
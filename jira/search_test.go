@@ -3,9 +3,13 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestSearchService_Do(t *testing.T) {
@@ -185,3 +189,289 @@ func TestSearchResult_JSON(t *testing.T) {
 		t.Errorf("Issues[0].Key = %v, want %v", result.Issues[0].Key, "TEST-1")
 	}
 }
+
+func TestSearchService_Do_ValidateQueryWarn(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("validateQuery"); got != ValidateQueryWarn {
+			t.Errorf("validateQuery = %v, want %v", got, ValidateQueryWarn)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SearchResult{
+			Issues:          []*Issue{},
+			WarningMessages: []string{"The value 'nobody' does not exist for the field 'assignee'."},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, _, err := client.Search.Do(context.Background(), "assignee = nobody", &SearchOptions{
+		ValidateQuery: ValidateQueryWarn,
+	})
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if len(result.WarningMessages) != 1 {
+		t.Errorf("len(WarningMessages) = %v, want %v", len(result.WarningMessages), 1)
+	}
+}
+
+func TestSearchService_SearchConsistent(t *testing.T) {
+	defer func(d time.Duration) { consistencyInitialDelay = d }(consistencyInitialDelay)
+	consistencyInitialDelay = time.Millisecond
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		issues := []*Issue{{Key: "TEST-1"}}
+		if calls >= 3 {
+			issues = append(issues, &Issue{Key: "TEST-2"})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SearchResult{Issues: issues})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	issues, err := client.Search.SearchConsistent(context.Background(), "project = TEST", nil, []string{"TEST-2"}, time.Second)
+	if err != nil {
+		t.Fatalf("SearchConsistent() error = %v", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("len(issues) = %v, want %v", len(issues), 2)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %v, want %v", calls, 3)
+	}
+}
+
+func TestSearchService_SearchConsistent_Timeout(t *testing.T) {
+	defer func(d time.Duration) { consistencyInitialDelay = d }(consistencyInitialDelay)
+	consistencyInitialDelay = time.Millisecond
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SearchResult{Issues: []*Issue{{Key: "TEST-1"}}})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	issues, err := client.Search.SearchConsistent(context.Background(), "project = TEST", nil, []string{"TEST-9"}, 20*time.Millisecond)
+	if err == nil {
+		t.Fatal("SearchConsistent() expected timeout error")
+	}
+	if len(issues) != 1 {
+		t.Errorf("len(issues) = %v, want %v", len(issues), 1)
+	}
+}
+
+func TestSearchService_Stream(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if got := r.URL.Query().Get("maxResults"); got != "2" {
+			t.Errorf("maxResults = %v, want %v", got, "2")
+		}
+
+		result := SearchResult{}
+		switch token := r.URL.Query().Get("nextPageToken"); token {
+		case "":
+			result.Issues = []*Issue{{Key: "TEST-1"}, {Key: "TEST-2"}}
+			result.NextPageToken = "page-2"
+		case "page-2":
+			result.Issues = []*Issue{{Key: "TEST-3"}}
+			result.IsLast = true
+		default:
+			t.Errorf("nextPageToken = %v", token)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	var keys []string
+	for issue, err := range client.Search.Stream(context.Background(), "project = TEST", &SearchOptions{MaxResults: 2}) {
+		if err != nil {
+			t.Fatalf("Stream() error = %v", err)
+		}
+		keys = append(keys, issue.Key)
+	}
+	if len(keys) != 3 || keys[2] != "TEST-3" {
+		t.Errorf("keys = %v, want [TEST-1 TEST-2 TEST-3]", keys)
+	}
+	if requests != 2 {
+		t.Errorf("requests = %v, want 2", requests)
+	}
+}
+
+func TestSearchService_Do_EncodesOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := "expand=names&expand=renderedFields&fields=summary&fields=status&fieldsByKeys=true" +
+			"&jql=project+%3D+TEST&maxResults=25&nextPageToken=abc&properties=sprint.info&validateQuery=warn"
+		if r.URL.RawQuery != want {
+			t.Errorf("query = %v, want %v", r.URL.RawQuery, want)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"issues":[{"key":"TEST-1"}],"isLast":true}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, _, err := client.Search.Do(context.Background(), "project = TEST", &SearchOptions{
+		Fields:        []string{"summary", "status"},
+		Expand:        []string{"names", "renderedFields"},
+		Properties:    []string{"sprint.info"},
+		FieldsByKeys:  true,
+		MaxResults:    25,
+		NextPageToken: "abc",
+		ValidateQuery: ValidateQueryWarn,
+	})
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if len(result.Issues) != 1 || !result.IsLast {
+		t.Errorf("result = %+v, want one issue on the last page", result)
+	}
+}
+
+func TestSearchService_DoAll(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if got := r.URL.Query().Get("maxResults"); got != "2" {
+			t.Errorf("maxResults = %v, want %v", got, "2")
+		}
+
+		result := SearchResult{Total: 5}
+		switch token := r.URL.Query().Get("nextPageToken"); token {
+		case "":
+			result.Issues = []*Issue{{Key: "TEST-1"}, {Key: "TEST-2"}}
+			result.NextPageToken = "page-2"
+		case "page-2":
+			result.Issues = []*Issue{{Key: "TEST-3"}, {Key: "TEST-4"}}
+			result.NextPageToken = "page-3"
+		case "page-3":
+			result.Issues = []*Issue{{Key: "TEST-5"}}
+			result.NextPageToken = "page-4"
+		default:
+			t.Errorf("nextPageToken = %v", token)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+
+	tests := []struct {
+		name         string
+		limit        int
+		wantKeys     int
+		wantRequests int
+	}{
+		{"all pages", 0, 5, 3},
+		{"limit", 3, 3, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+			issues, err := client.Search.DoAll(context.Background(), "project = TEST", &SearchOptions{MaxResults: 2, Limit: tt.limit})
+			if err != nil {
+				t.Fatalf("DoAll() error = %v", err)
+			}
+			if len(issues) != tt.wantKeys {
+				t.Errorf("len(issues) = %v, want %v", len(issues), tt.wantKeys)
+			}
+			if issues[len(issues)-1].Key != fmt.Sprintf("TEST-%d", tt.wantKeys) {
+				t.Errorf("last issue = %v, want TEST-%d", issues[len(issues)-1].Key, tt.wantKeys)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("requests = %v, want %v", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestSearchService_DoAll_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("nextPageToken") == "" {
+			w.Write([]byte(`{"issues":[{"key":"TEST-1"}],"nextPageToken":"page-2"}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errorMessages":["bad page"]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	issues, err := client.Search.DoAll(context.Background(), "project = TEST", nil)
+	if err == nil {
+		t.Fatal("DoAll() error = nil, want error")
+	}
+	if issues != nil {
+		t.Errorf("issues = %v, want nil", issues)
+	}
+}
+
+func TestSearchService_DoWithFieldNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if got := strings.Join(q["expand"], ","); got != "changelog,names,schema,renderedFields" {
+			t.Errorf("expand = %v, want %v", got, "changelog,names,schema,renderedFields")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if q.Get("nextPageToken") == "" {
+			w.Write([]byte(`{"issues":[{"key":"TEST-1"}],"nextPageToken":"page-2",` +
+				`"names":{"summary":"Summary","customfield_10010":"Sprint"}}`))
+			return
+		}
+		w.Write([]byte(`{"issues":[{"key":"TEST-2"}],"isLast":true,` +
+			`"names":{"summary":"Summary","customfield_10020":"Story Points"}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	opts := &SearchOptions{Expand: []string{"changelog", "names"}}
+	issues, names, _, err := client.Search.DoWithFieldNames(context.Background(), "project = TEST", opts)
+	if err != nil {
+		t.Fatalf("DoWithFieldNames() error = %v", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("len(issues) = %v, want %v", len(issues), 2)
+	}
+	want := map[string]string{"summary": "Summary", "customfield_10010": "Sprint", "customfield_10020": "Story Points"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("names = %v, want %v", names, want)
+	}
+	if len(opts.Expand) != 2 {
+		t.Errorf("opts.Expand = %v, want it unchanged", opts.Expand)
+	}
+}
+
+func TestSearchService_DoWithFieldNames_StopsAtTotal(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"issues":[{"key":"TEST-1"}],"total":1,"nextPageToken":"page-2","names":{"summary":"Summary"}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	issues, _, resp, err := client.Search.DoWithFieldNames(context.Background(), "project = TEST", nil)
+	if err != nil {
+		t.Fatalf("DoWithFieldNames() error = %v", err)
+	}
+	if len(issues) != 1 || requests != 1 {
+		t.Errorf("len(issues) = %v, requests = %v, want 1 of each", len(issues), requests)
+	}
+	if resp == nil || resp.Total != 1 {
+		t.Errorf("Response = %+v, want the last page's", resp)
+	}
+}
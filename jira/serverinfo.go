@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	"net/http"
+	"strings"
 )
 
 // ServerInfoService handles server info operations for the Jira API.
@@ -10,6 +11,12 @@ type ServerInfoService struct {
 	client *Client
 }
 
+// Deployment types reported in ServerInfo.DeploymentType.
+const (
+	DeploymentTypeCloud  = "Cloud"
+	DeploymentTypeServer = "Server"
+)
+
 // ServerInfo represents Jira server information.
 type ServerInfo struct {
 	BaseURL        string         `json:"baseUrl,omitempty"`
@@ -46,3 +53,22 @@ func (s *ServerInfoService) Get(ctx context.Context) (*ServerInfo, *Response, er
 
 	return info, resp, nil
 }
+
+// IsCloud reports whether the client talks to Jira Cloud rather than Jira
+// Server or Data Center, based on ServerInfo.DeploymentType. The server info
+// is fetched on the first call and reused after that; a failed fetch is
+// retried by the next call.
+func (c *Client) IsCloud(ctx context.Context) (bool, error) {
+	c.serverInfoMu.Lock()
+	defer c.serverInfoMu.Unlock()
+
+	if c.serverInfo == nil {
+		info, _, err := c.ServerInfo.Get(ctx)
+		if err != nil {
+			return false, err
+		}
+		c.serverInfo = info
+	}
+
+	return strings.EqualFold(c.serverInfo.DeploymentType, DeploymentTypeCloud), nil
+}
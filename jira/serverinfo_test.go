@@ -0,0 +1,119 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestServerInfoService_Get(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Method = %v, want %v", r.Method, http.MethodGet)
+		}
+		if r.URL.Path != "/rest/api/3/serverInfo" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/serverInfo")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"baseUrl": "https://example.atlassian.net",
+			"version": "1001.0.0-SNAPSHOT",
+			"versionNumbers": [1001, 0, 0],
+			"deploymentType": "Cloud",
+			"buildNumber": 100224,
+			"buildDate": "2024-03-01T00:00:00.000+0000",
+			"serverTime": "2024-03-04T10:15:00.000+0000",
+			"scmInfo": "8b4f2c1e",
+			"serverTitle": "Jira",
+			"healthChecks": [{"name": "dbConnection", "description": "Database", "passed": true}]
+		}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	info, _, err := client.ServerInfo.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	want := &ServerInfo{
+		BaseURL:        "https://example.atlassian.net",
+		Version:        "1001.0.0-SNAPSHOT",
+		VersionNumbers: []int{1001, 0, 0},
+		DeploymentType: "Cloud",
+		BuildNumber:    100224,
+		BuildDate:      "2024-03-01T00:00:00.000+0000",
+		ServerTime:     "2024-03-04T10:15:00.000+0000",
+		ScmInfo:        "8b4f2c1e",
+		ServerTitle:    "Jira",
+		HealthChecks:   []*HealthCheck{{Name: "dbConnection", Description: "Database", Passed: true}},
+	}
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("Get() = %+v, want %+v", info, want)
+	}
+}
+
+func TestClient_IsCloud(t *testing.T) {
+	tests := []struct {
+		deploymentType string
+		want           bool
+	}{
+		{DeploymentTypeCloud, true},
+		{DeploymentTypeServer, false},
+		{"DataCenter", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.deploymentType, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"deploymentType":"` + tt.deploymentType + `"}`))
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			for i := 0; i < 2; i++ {
+				got, err := client.IsCloud(context.Background())
+				if err != nil {
+					t.Fatalf("IsCloud() error = %v", err)
+				}
+				if got != tt.want {
+					t.Errorf("IsCloud() = %v, want %v", got, tt.want)
+				}
+			}
+			if requests != 1 {
+				t.Errorf("requests = %v, want 1", requests)
+			}
+		})
+	}
+}
+
+func TestClient_IsCloud_RetriesAfterError(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"deploymentType":"Cloud"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	if _, err := client.IsCloud(context.Background()); err == nil {
+		t.Fatal("IsCloud() error = nil, want error")
+	}
+	got, err := client.IsCloud(context.Background())
+	if err != nil {
+		t.Fatalf("IsCloud() error = %v", err)
+	}
+	if !got {
+		t.Errorf("IsCloud() = %v, want true", got)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 // StatusesService handles status operations for the Jira API.
@@ -59,7 +58,7 @@ type StatusListResult struct {
 	Values     []*Status `json:"values,omitempty"`
 }
 
-// SearchOptions specifies options for searching statuses.
+// StatusSearchOptions specifies options for searching statuses.
 type StatusSearchOptions struct {
 	StartAt        int    `url:"startAt,omitempty"`
 	MaxResults     int    `url:"maxResults,omitempty"`
@@ -117,8 +116,8 @@ func (s *StatusesService) BulkGet(ctx context.Context, ids []string, expand stri
 	u := "/rest/api/3/statuses"
 
 	params := url.Values{}
-	if len(ids) > 0 {
-		params.Set("id", strings.Join(ids, ","))
+	for _, id := range ids {
+		params.Add("id", id)
 	}
 	if expand != "" {
 		params.Set("expand", expand)
@@ -212,7 +211,11 @@ func (s *StatusesService) Delete(ctx context.Context, ids []string) (*Response,
 	u := "/rest/api/3/statuses"
 
 	if len(ids) > 0 {
-		u = fmt.Sprintf("%s?id=%s", u, strings.Join(ids, ","))
+		params := url.Values{}
+		for _, id := range ids {
+			params.Add("id", id)
+		}
+		u = fmt.Sprintf("%s?%s", u, params.Encode())
 	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
@@ -232,6 +235,13 @@ type StatusCategory struct {
 	Name      string `json:"name,omitempty"`
 }
 
+// Status category keys reported in StatusCategory.Key.
+const (
+	StatusCategoryToDo       = "new"
+	StatusCategoryInProgress = "indeterminate"
+	StatusCategoryDone       = "done"
+)
+
 // ListCategories returns all status categories.
 func (s *StatusesService) ListCategories(ctx context.Context) ([]*StatusCategory, *Response, error) {
 	req, err := s.client.NewRequest(ctx, http.MethodGet, "/rest/api/3/statuscategory", nil)
@@ -0,0 +1,120 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestStatusesService_Search(t *testing.T) {
+	statuses := []*Status{{ID: "1", Name: "To Do"}, {ID: "3", Name: "In Progress"}, {ID: "10001", Name: "Done"}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/statuses/search" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/statuses/search")
+		}
+		q := r.URL.Query()
+		if got := q.Get("projectId"); got != "10000" {
+			t.Errorf("projectId = %v, want %v", got, "10000")
+		}
+		if got := q.Get("statusCategory"); got != "IN_PROGRESS" {
+			t.Errorf("statusCategory = %v, want %v", got, "IN_PROGRESS")
+		}
+
+		startAt, _ := strconv.Atoi(q.Get("startAt"))
+		end := min(startAt+2, len(statuses))
+		result := StatusListResult{
+			StartAt:    startAt,
+			MaxResults: 2,
+			Total:      len(statuses),
+			IsLast:     end == len(statuses),
+			Values:     statuses[startAt:end],
+		}
+		if !result.IsLast {
+			result.NextPage = "/rest/api/3/statuses/search?startAt=" + strconv.Itoa(end)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	ctx := context.Background()
+	pages := Paginate(ctx, func(startAt int) ([]*Status, *Response, error) {
+		result, resp, err := client.Statuses.Search(ctx, &StatusSearchOptions{
+			StartAt:        startAt,
+			MaxResults:     2,
+			ProjectID:      "10000",
+			StatusCategory: "IN_PROGRESS",
+		})
+		if err != nil {
+			return nil, resp, err
+		}
+		return result.Values, resp, nil
+	})
+
+	var names []string
+	for status, err := range pages {
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		names = append(names, status.Name)
+	}
+	if got := strings.Join(names, ","); got != "To Do,In Progress,Done" {
+		t.Errorf("statuses = %v, want %v", got, "To Do,In Progress,Done")
+	}
+}
+
+func TestStatusesService_IDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/statuses" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/statuses")
+		}
+		if got := r.URL.Query()["id"]; len(got) != 2 || got[0] != "1" || got[1] != "3" {
+			t.Errorf("%s id = %v, want [1 3]", r.Method, got)
+		}
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":"1","name":"To Do"},{"id":"3","name":"In Progress"}]`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	statuses, _, err := client.Statuses.BulkGet(context.Background(), []string{"1", "3"}, "")
+	if err != nil {
+		t.Fatalf("BulkGet() error = %v", err)
+	}
+	if len(statuses) != 2 {
+		t.Errorf("len(statuses) = %v, want %v", len(statuses), 2)
+	}
+	if _, err := client.Statuses.Delete(context.Background(), []string{"1", "3"}); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+}
+
+func TestStatusesService_ListCategories(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/statuscategory" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/statuscategory")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":2,"key":"new","colorName":"blue-gray","name":"To Do"},{"id":3,"key":"done","colorName":"green","name":"Done"}]`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	categories, _, err := client.Statuses.ListCategories(context.Background())
+	if err != nil {
+		t.Fatalf("ListCategories() error = %v", err)
+	}
+	if len(categories) != 2 || categories[1].Key != "done" || categories[1].ID != 3 {
+		t.Errorf("categories = %+v", categories)
+	}
+}
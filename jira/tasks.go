@@ -0,0 +1,144 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TasksService handles long-running task operations for the Jira API, such
+// as asynchronous project deletion and reindexing.
+type TasksService struct {
+	client *Client
+}
+
+// Task statuses reported in Task.Status.
+const (
+	TaskEnqueued        = "ENQUEUED"
+	TaskRunning         = "RUNNING"
+	TaskComplete        = "COMPLETE"
+	TaskFailed          = "FAILED"
+	TaskCancelRequested = "CANCEL_REQUESTED"
+	TaskCancelled       = "CANCELLED"
+	TaskDead            = "DEAD"
+)
+
+// Task represents a long-running task. Times are in milliseconds since the
+// epoch.
+type Task struct {
+	Self           string `json:"self,omitempty"`
+	ID             string `json:"id,omitempty"`
+	Description    string `json:"description,omitempty"`
+	Status         string `json:"status,omitempty"` // One of the Task* constants
+	Message        string `json:"message,omitempty"`
+	Result         any    `json:"result,omitempty"`
+	SubmittedBy    int64  `json:"submittedBy,omitempty"`
+	Progress       int64  `json:"progress,omitempty"` // Percent complete
+	ElapsedRuntime int64  `json:"elapsedRuntime,omitempty"`
+	Submitted      int64  `json:"submitted,omitempty"`
+	Started        int64  `json:"started,omitempty"`
+	Finished       int64  `json:"finished,omitempty"`
+	LastUpdate     int64  `json:"lastUpdate,omitempty"`
+}
+
+// Done reports whether the task has stopped, successfully or not.
+func (t *Task) Done() bool {
+	switch t.Status {
+	case TaskComplete, TaskFailed, TaskCancelled, TaskDead:
+		return true
+	}
+	return false
+}
+
+// Err returns a *TaskError if the task stopped without completing, and nil
+// otherwise.
+func (t *Task) Err() error {
+	switch t.Status {
+	case TaskFailed, TaskCancelled, TaskDead:
+		return &TaskError{Task: t}
+	}
+	return nil
+}
+
+// TaskError reports a task that failed, was cancelled, or died.
+type TaskError struct {
+	Task *Task
+}
+
+func (e *TaskError) Error() string {
+	if e.Task.Message != "" {
+		return fmt.Sprintf("task %s %s: %s", e.Task.ID, e.Task.Status, e.Task.Message)
+	}
+	return fmt.Sprintf("task %s %s", e.Task.ID, e.Task.Status)
+}
+
+// Get returns the status of a long-running task.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-tasks/#api-rest-api-3-task-taskid-get
+func (s *TasksService) Get(ctx context.Context, taskID string) (*Task, *Response, error) {
+	u := fmt.Sprintf("/rest/api/3/task/%s", taskID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(Task)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return task, resp, nil
+}
+
+// Cancel asks Jira to cancel a long-running task. The task reports
+// TaskCancelRequested until it stops.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-tasks/#api-rest-api-3-task-taskid-cancel-post
+func (s *TasksService) Cancel(ctx context.Context, taskID string) (*Response, error) {
+	u := fmt.Sprintf("/rest/api/3/task/%s/cancel", taskID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DefaultTaskPollInterval is how often Wait polls a task when given no
+// interval.
+const DefaultTaskPollInterval = time.Second
+
+// Wait polls a task every poll interval until it stops, and returns it. A
+// task that failed, was cancelled or died is returned along with its
+// *TaskError. If ctx is done or a poll fails first, Wait returns the last
+// status it saw, if any, and the error. A poll of zero or less uses
+// DefaultTaskPollInterval.
+func (s *TasksService) Wait(ctx context.Context, taskID string, poll time.Duration) (*Task, error) {
+	if poll <= 0 {
+		poll = DefaultTaskPollInterval
+	}
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+
+	var last *Task
+	for {
+		task, _, err := s.Get(ctx, taskID)
+		if err != nil {
+			return last, err
+		}
+		if task.Done() {
+			return task, task.Err()
+		}
+		last = task
+
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTasksService_Wait(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/task/10100" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/task/10100")
+		}
+		polls++
+		w.Header().Set("Content-Type", "application/json")
+		if polls < 3 {
+			w.Write([]byte(`{"id":"10100","status":"RUNNING","progress":50}`))
+			return
+		}
+		w.Write([]byte(`{"id":"10100","status":"COMPLETE","progress":100,"result":"done"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	task, err := client.Tasks.Wait(context.Background(), "10100", time.Millisecond)
+	if err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if task.Status != TaskComplete || task.Progress != 100 || task.Result != "done" {
+		t.Errorf("task = %+v, want complete", task)
+	}
+	if polls != 3 {
+		t.Errorf("polls = %v, want 3", polls)
+	}
+}
+
+func TestTasksService_Wait_Failed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"10100","status":"FAILED","message":"Project is locked"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	task, err := client.Tasks.Wait(context.Background(), "10100", time.Millisecond)
+	var taskErr *TaskError
+	if !errors.As(err, &taskErr) || taskErr.Task != task {
+		t.Errorf("Wait() error = %v, want *TaskError", err)
+	}
+}
+
+func TestTasksService_Wait_ContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"10100","status":"RUNNING"}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	client, _ := NewClient(server.URL)
+	task, err := client.Tasks.Wait(ctx, "10100", 5*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if task != nil && task.Status != TaskRunning {
+		t.Errorf("task = %+v, want last RUNNING status", task)
+	}
+}
+
+func TestTasksService_Cancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/task/10100/cancel" {
+			t.Errorf("request = %v %v, want POST /rest/api/3/task/10100/cancel", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	if _, err := client.Tasks.Cancel(context.Background(), "10100"); err != nil {
+		t.Errorf("Cancel() error = %v", err)
+	}
+}
+
+func TestTasksService_Wait_ZeroPoll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"10100","status":"COMPLETE"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	task, err := client.Tasks.Wait(context.Background(), "10100", 0)
+	if err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if task.Status != TaskComplete {
+		t.Errorf("Status = %v, want %v", task.Status, TaskComplete)
+	}
+}
@@ -2,6 +2,10 @@ package jira
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
 	"time"
 )
 
@@ -10,8 +14,19 @@ type Time struct {
 	time.Time
 }
 
-// UnmarshalJSON implements json.Unmarshaler for Time.
+// UnmarshalJSON implements json.Unmarshaler for Time. It accepts Jira's
+// timestamp strings and, as some payloads use, a number of milliseconds
+// since the epoch.
 func (t *Time) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && (data[0] == '-' || (data[0] >= '0' && data[0] <= '9')) {
+		ms, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid epoch milliseconds %s: %w", data, err)
+		}
+		t.Time = time.UnixMilli(ms).UTC()
+		return nil
+	}
+
 	var s string
 	if err := json.Unmarshal(data, &s); err != nil {
 		return err
@@ -23,6 +38,7 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 	// Try different formats
 	formats := []string{
 		"2006-01-02T15:04:05.000-0700",
+		"2006-01-02T15:04:05.000-07:00",
 		"2006-01-02T15:04:05.000Z",
 		"2006-01-02T15:04:05Z",
 		"2006-01-02",
@@ -40,7 +56,8 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 	return parseErr
 }
 
-// MarshalJSON implements json.Marshaler for Time.
+// MarshalJSON implements json.Marshaler for Time. The time is written with
+// its own offset, so a Time decoded from Jira is written back unchanged.
 func (t Time) MarshalJSON() ([]byte, error) {
 	if t.IsZero() {
 		return []byte("null"), nil
@@ -89,6 +106,7 @@ type User struct {
 	Active       bool              `json:"active,omitempty"`
 	TimeZone     string            `json:"timeZone,omitempty"`
 	Locale       string            `json:"locale,omitempty"`
+	Name         string            `json:"name,omitempty"` // Username; Jira Server and Data Center only
 }
 
 // Project represents a Jira project.
@@ -154,6 +172,10 @@ type Component struct {
 	IsAssigneeTypeValid bool   `json:"isAssigneeTypeValid,omitempty"`
 	Project             string `json:"project,omitempty"`
 	ProjectID           int    `json:"projectId,omitempty"`
+
+	// IssueCount is only populated by ComponentsService.ListProjectComponents
+	// and ListForProjectWithCounts.
+	IssueCount int `json:"issueCount,omitempty"`
 }
 
 // IssueType represents an issue type.
@@ -186,6 +208,7 @@ type Resolution struct {
 	ID          string `json:"id,omitempty"`
 	Name        string `json:"name,omitempty"`
 	Description string `json:"description,omitempty"`
+	IsDefault   bool   `json:"isDefault,omitempty"`
 }
 
 // Status represents an issue status.
@@ -219,6 +242,7 @@ type FieldMeta struct {
 	Schema          *Schema  `json:"schema,omitempty"`
 	Name            string   `json:"name,omitempty"`
 	Key             string   `json:"key,omitempty"`
+	FieldID         string   `json:"fieldId,omitempty"`
 	AutoCompleteURL string   `json:"autoCompleteUrl,omitempty"`
 	HasDefaultValue bool     `json:"hasDefaultValue,omitempty"`
 	Operations      []string `json:"operations,omitempty"`
@@ -332,3 +356,51 @@ type WorkflowOperations struct {
 	CanEdit   bool `json:"canEdit,omitempty"`
 	CanDelete bool `json:"canDelete,omitempty"`
 }
+
+// IDFromSelf extracts the object identifier from a Self URL, such as
+// "10000" from ".../rest/api/3/project/10000". User and group Self URLs carry
+// the identifier as a query parameter (".../user?accountId=5b10a2844c20165700ede21g"),
+// and the account or group ID is returned for those. It reports false if self
+// is not a URL or has no identifier.
+func IDFromSelf(self string) (string, bool) {
+	if self == "" {
+		return "", false
+	}
+	u, err := url.Parse(self)
+	if err != nil {
+		return "", false
+	}
+	query := u.Query()
+	if id := query.Get("accountId"); id != "" {
+		return id, true
+	}
+	if id := query.Get("groupId"); id != "" {
+		return id, true
+	}
+	// Take the last segment of the escaped path, so that an escaped slash
+	// stays in the identifier, and unescape it once.
+	id := path.Base(u.EscapedPath())
+	switch id {
+	case ".", "/", "user", "group":
+		return "", false
+	}
+	if unescaped, err := url.PathUnescape(id); err == nil {
+		id = unescaped
+	}
+	return id, true
+}
+
+// Int64IDFromSelf is like IDFromSelf for objects with numeric IDs, such as
+// projects, issues, filters and dashboards. It reports false if the
+// identifier is not an integer.
+func Int64IDFromSelf(self string) (int64, bool) {
+	id, ok := IDFromSelf(self)
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
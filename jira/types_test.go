@@ -53,11 +53,43 @@ func TestTime_UnmarshalJSON(t *testing.T) {
 				return jt.IsZero()
 			},
 		},
+		{
+			name:  "datetime with colon in offset",
+			input: `"2024-01-15T10:30:45.123-05:00"`,
+			check: func(jt *Time) bool {
+				_, offset := jt.Zone()
+				return jt.Hour() == 10 && offset == -5*60*60
+			},
+		},
+		{
+			name:  "epoch milliseconds",
+			input: `1705332645123`,
+			check: func(jt *Time) bool {
+				return jt.Equal(time.Date(2024, time.January, 15, 15, 30, 45, 123000000, time.UTC))
+			},
+		},
+		{
+			name:  "null",
+			input: `null`,
+			check: func(jt *Time) bool {
+				return jt.IsZero()
+			},
+		},
 		{
 			name:    "invalid format",
 			input:   `"not-a-date"`,
 			wantErr: true,
 		},
+		{
+			name:    "fractional epoch",
+			input:   `1705332645.5`,
+			wantErr: true,
+		},
+		{
+			name:    "boolean",
+			input:   `true`,
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -107,6 +139,33 @@ func TestTime_MarshalJSON(t *testing.T) {
 	}
 }
 
+func TestTime_RoundTripPreservesOffset(t *testing.T) {
+	for _, input := range []string{
+		`"2021-03-01T12:00:00.000+0530"`,
+		`"2021-03-01T12:00:00.000-0330"`,
+		`"2021-03-01T12:00:00.000+0000"`,
+	} {
+		var jt Time
+		if err := json.Unmarshal([]byte(input), &jt); err != nil {
+			t.Fatalf("UnmarshalJSON(%s) error = %v", input, err)
+		}
+		got, err := json.Marshal(jt)
+		if err != nil {
+			t.Fatalf("MarshalJSON() error = %v", err)
+		}
+		if string(got) != input {
+			t.Errorf("round trip of %s = %s", input, got)
+		}
+	}
+
+	// A colon offset comes back in Jira's own format, with the same offset.
+	var jt Time
+	json.Unmarshal([]byte(`"2021-03-01T12:00:00.000+05:30"`), &jt)
+	if got, _ := json.Marshal(jt); string(got) != `"2021-03-01T12:00:00.000+0530"` {
+		t.Errorf("round trip of colon offset = %s", got)
+	}
+}
+
 func TestDate_UnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -315,3 +374,38 @@ func TestTransition_JSON(t *testing.T) {
 		t.Error("IsAvailable = false, want true")
 	}
 }
+
+func TestIDFromSelf(t *testing.T) {
+	tests := []struct {
+		self   string
+		want   string
+		wantOK bool
+	}{
+		{"https://example.atlassian.net/rest/api/3/project/10000", "10000", true},
+		{"https://example.atlassian.net/rest/api/3/project/10000/", "10000", true},
+		{"https://example.atlassian.net/rest/api/3/user?accountId=5b10a2844c20165700ede21g", "5b10a2844c20165700ede21g", true},
+		{"https://example.atlassian.net/rest/api/3/group?groupId=276f955c-63d7-42c8-9520-92d01dca0625", "276f955c-63d7-42c8-9520-92d01dca0625", true},
+		{"https://example.atlassian.net/rest/api/3/group?groupname=jira-users", "", false},
+		{"https://example.atlassian.net/rest/api/3/issue/TEST-1/properties/100%2525", "100%25", true},
+		{"https://example.atlassian.net/rest/api/3/issue/TEST-1/properties/a%2Fb", "a/b", true},
+		{"https://example.atlassian.net/", "", false},
+		{"", "", false},
+		{"://bad", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := IDFromSelf(tt.self)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("IDFromSelf(%q) = %q, %v, want %q, %v", tt.self, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestInt64IDFromSelf(t *testing.T) {
+	if id, ok := Int64IDFromSelf("https://example.atlassian.net/rest/api/3/filter/10042"); !ok || id != 10042 {
+		t.Errorf("Int64IDFromSelf() = %v, %v, want %v, true", id, ok, 10042)
+	}
+	if _, ok := Int64IDFromSelf("https://example.atlassian.net/rest/api/3/issue/TEST-1"); ok {
+		t.Error("Int64IDFromSelf() ok = true for non-numeric ID")
+	}
+}
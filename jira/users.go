@@ -2,11 +2,13 @@ package jira
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 // UsersService handles user operations for the Jira API.
@@ -75,9 +77,10 @@ func (s *UsersService) Search(ctx context.Context, opts *UserSearchOptions) ([]*
 	return users, resp, nil
 }
 
-// Get returns a user by account ID.
+// Get returns a user by account ID, or by username if the client uses
+// UserIdentifierUsername.
 func (s *UsersService) Get(ctx context.Context, accountID string, expand []string) (*User, *Response, error) {
-	u := fmt.Sprintf("/rest/api/3/user?accountId=%s", accountID)
+	u := fmt.Sprintf("/rest/api/3/user?%s=%s", s.client.userQueryKey(), url.QueryEscape(accountID))
 
 	if len(expand) > 0 {
 		u = fmt.Sprintf("%s&expand=%s", u, strings.Join(expand, ","))
@@ -122,9 +125,10 @@ func (s *UsersService) Create(ctx context.Context, user *UserCreateRequest) (*Us
 	return result, resp, nil
 }
 
-// Delete removes a user.
+// Delete removes a user by account ID, or by username if the client uses
+// UserIdentifierUsername.
 func (s *UsersService) Delete(ctx context.Context, accountID string) (*Response, error) {
-	u := fmt.Sprintf("/rest/api/3/user?accountId=%s", accountID)
+	u := fmt.Sprintf("/rest/api/3/user?%s=%s", s.client.userQueryKey(), url.QueryEscape(accountID))
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
 	if err != nil {
@@ -307,6 +311,67 @@ func (s *UsersService) GetGroups(ctx context.Context, accountID string) ([]*Grou
 	return groups, resp, nil
 }
 
+// AddToGroups adds a user to each of the groups identified by groupIDs,
+// issuing the requests concurrently. All group IDs are validated before any
+// request is sent. Failures are joined into the returned error, one per group.
+func (s *UsersService) AddToGroups(ctx context.Context, accountID string, groupIDs []string) error {
+	return s.updateGroups(ctx, accountID, groupIDs, http.MethodPost)
+}
+
+// RemoveFromGroups removes a user from each of the groups identified by
+// groupIDs, issuing the requests concurrently. All group IDs are validated
+// before any request is sent. Failures are joined into the returned error,
+// one per group.
+func (s *UsersService) RemoveFromGroups(ctx context.Context, accountID string, groupIDs []string) error {
+	return s.updateGroups(ctx, accountID, groupIDs, http.MethodDelete)
+}
+
+// updateGroups adds (POST) or removes (DELETE) a user's membership of groups.
+func (s *UsersService) updateGroups(ctx context.Context, accountID string, groupIDs []string, method string) error {
+	if accountID == "" {
+		return errors.New("account ID must not be empty")
+	}
+	for i, id := range groupIDs {
+		if strings.TrimSpace(id) == "" {
+			return fmt.Errorf("group ID at index %d must not be empty", i)
+		}
+	}
+
+	errs := make([]error, len(groupIDs))
+	var wg sync.WaitGroup
+	for i, groupID := range groupIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := s.client.acquireBatchSlot(ctx); err != nil {
+				errs[i] = fmt.Errorf("group %s: %w", groupID, err)
+				return
+			}
+			defer s.client.releaseBatchSlot()
+
+			var req *http.Request
+			var err error
+			if method == http.MethodPost {
+				u := fmt.Sprintf("/rest/api/3/group/user?groupId=%s", url.QueryEscape(groupID))
+				req, err = s.client.NewRequest(ctx, method, u, &AddUserRequest{AccountID: accountID})
+			} else {
+				u := fmt.Sprintf("/rest/api/3/group/user?groupId=%s&accountId=%s", url.QueryEscape(groupID), url.QueryEscape(accountID))
+				req, err = s.client.NewRequest(ctx, method, u, nil)
+			}
+			if err == nil {
+				_, err = s.client.Do(req, nil)
+			}
+			if err != nil {
+				errs[i] = fmt.Errorf("group %s: %w", groupID, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
 // GroupName represents a group name.
 type GroupName struct {
 	Name    string `json:"name,omitempty"`
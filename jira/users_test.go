@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -42,6 +44,44 @@ func TestUsersService_Get(t *testing.T) {
 	}
 }
 
+func TestUsersService_UserIdentifierMode(t *testing.T) {
+	tests := []struct {
+		mode      UserIdentifierMode
+		wantQuery string
+	}{
+		{UserIdentifierAccountID, "accountId=jdoe"},
+		{UserIdentifierUsername, "username=jdoe"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.RawQuery != tt.wantQuery {
+					t.Errorf("%s query = %v, want %v", r.Method, r.URL.RawQuery, tt.wantQuery)
+				}
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"name":"jdoe"}`))
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL, WithUserIdentifierMode(tt.mode))
+			user, _, err := client.Users.Get(context.Background(), "jdoe", nil)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if user.Name != "jdoe" {
+				t.Errorf("Name = %v, want %v", user.Name, "jdoe")
+			}
+			if _, err := client.Users.Delete(context.Background(), "jdoe"); err != nil {
+				t.Errorf("Delete() error = %v", err)
+			}
+		})
+	}
+}
+
 func TestUsersService_Search(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/rest/api/3/user/search" {
@@ -220,3 +260,52 @@ func TestUsersService_BulkGet(t *testing.T) {
 		t.Errorf("len(Values) = %v, want %v", len(result.Values), 2)
 	}
 }
+
+func TestUsersService_AddToGroups(t *testing.T) {
+	var mu sync.Mutex
+	var added []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Method = %v, want %v", r.Method, http.MethodPost)
+		}
+		if r.URL.Path != "/rest/api/3/group/user" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/group/user")
+		}
+
+		groupID := r.URL.Query().Get("groupId")
+		if groupID == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mu.Lock()
+		added = append(added, groupID)
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(Group{GroupID: groupID})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	err := client.Users.AddToGroups(context.Background(), "123", []string{"g1", "missing", "g2"})
+	if err == nil {
+		t.Fatal("AddToGroups() expected error for missing group")
+	}
+	if !strings.Contains(err.Error(), "group missing") {
+		t.Errorf("error = %v, want mention of missing group", err)
+	}
+	if len(added) != 2 {
+		t.Errorf("len(added) = %v, want %v", len(added), 2)
+	}
+}
+
+func TestUsersService_RemoveFromGroups_Validation(t *testing.T) {
+	client, _ := NewClient("https://example.atlassian.net")
+	if err := client.Users.RemoveFromGroups(context.Background(), "123", []string{"g1", " "}); err == nil {
+		t.Error("RemoveFromGroups() expected error for empty group ID")
+	}
+	if err := client.Users.RemoveFromGroups(context.Background(), "", []string{"g1"}); err == nil {
+		t.Error("RemoveFromGroups() expected error for empty account ID")
+	}
+}
@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -285,8 +286,37 @@ type VersionListResult struct {
 	Values     []*Version `json:"values,omitempty"`
 }
 
-// ListProjectVersions returns versions for a project.
+// Version statuses accepted by ListProjectVersions. Combine several with commas.
+const (
+	VersionStatusReleased   = "released"
+	VersionStatusUnreleased = "unreleased"
+	VersionStatusArchived   = "archived"
+)
+
+// validateVersionStatus checks a comma-separated version status filter. Jira
+// ignores unknown values and returns every version, so catch them here.
+func validateVersionStatus(status string) error {
+	if status == "" {
+		return nil
+	}
+	for _, v := range strings.Split(status, ",") {
+		switch strings.TrimSpace(v) {
+		case VersionStatusReleased, VersionStatusUnreleased, VersionStatusArchived:
+		default:
+			return fmt.Errorf("invalid version status %q: must be %s, %s or %s",
+				v, VersionStatusReleased, VersionStatusUnreleased, VersionStatusArchived)
+		}
+	}
+	return nil
+}
+
+// ListProjectVersions returns versions for a project. status is a
+// comma-separated list of VersionStatus values; an unknown value is an error.
 func (s *VersionsService) ListProjectVersions(ctx context.Context, projectIDOrKey string, startAt, maxResults int, orderBy, query, status string, expand []string) (*VersionListResult, *Response, error) {
+	if err := validateVersionStatus(status); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("/rest/api/3/project/%s/version", projectIDOrKey)
 
 	params := url.Values{}
@@ -326,6 +356,38 @@ func (s *VersionsService) ListProjectVersions(ctx context.Context, projectIDOrKe
 	return result, resp, nil
 }
 
+// StreamProjectVersions returns an iterator over every version of a project
+// matching the filters, fetching further pages as the loop advances. Iteration
+// stops after the first error, which is yielded with a nil version.
+//
+//	for version, err := range client.Versions.StreamProjectVersions(ctx, "PROJ", "", "", jira.VersionStatusUnreleased, nil) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(version.Name)
+//	}
+func (s *VersionsService) StreamProjectVersions(ctx context.Context, projectIDOrKey, orderBy, query, status string, expand []string) iter.Seq2[*Version, error] {
+	return func(yield func(*Version, error) bool) {
+		startAt := 0
+		for {
+			result, _, err := s.ListProjectVersions(ctx, projectIDOrKey, startAt, 0, orderBy, query, status, expand)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, version := range result.Values {
+				if !yield(version, nil) {
+					return
+				}
+			}
+			startAt += len(result.Values)
+			if result.IsLast || len(result.Values) == 0 {
+				return
+			}
+		}
+	}
+}
+
 // ListAllProjectVersions returns all versions for a project (non-paginated).
 func (s *VersionsService) ListAllProjectVersions(ctx context.Context, projectIDOrKey string, expand []string) ([]*Version, *Response, error) {
 	u := fmt.Sprintf("/rest/api/3/project/%s/versions", projectIDOrKey)
@@ -0,0 +1,54 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestVersionsService_ListProjectVersions_InvalidStatus(t *testing.T) {
+	client, _ := NewClient("https://example.atlassian.net")
+	_, _, err := client.Versions.ListProjectVersions(context.Background(), "TEST", 0, 0, "", "", "released,shipped", nil)
+	if err == nil {
+		t.Error("ListProjectVersions() expected error for invalid status")
+	}
+}
+
+func TestVersionsService_StreamProjectVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/project/TEST/version" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/project/TEST/version")
+		}
+		if got := r.URL.Query().Get("status"); got != "released,archived" {
+			t.Errorf("status = %v, want %v", got, "released,archived")
+		}
+
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		result := VersionListResult{StartAt: startAt, Total: 3}
+		if startAt == 0 {
+			result.Values = []*Version{{Name: "1.0"}, {Name: "1.1"}}
+		} else {
+			result.Values = []*Version{{Name: "2.0"}}
+			result.IsLast = true
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	var names []string
+	for version, err := range client.Versions.StreamProjectVersions(context.Background(), "TEST", "", "", "released,archived", nil) {
+		if err != nil {
+			t.Fatalf("StreamProjectVersions() error = %v", err)
+		}
+		names = append(names, version.Name)
+	}
+	if len(names) != 3 || names[2] != "2.0" {
+		t.Errorf("names = %v, want [1.0 1.1 2.0]", names)
+	}
+}
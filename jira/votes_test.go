@@ -0,0 +1,66 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVotesService_Get(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/votes" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/issue/TEST-1/votes")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"self":"https://example.atlassian.net/rest/api/3/issue/TEST-1/votes","votes":2,"hasVoted":true,` +
+			`"voters":[{"accountId":"5b10a2844c20165700ede21g"},{"accountId":"5b10ac8d82e05b22cc7d4ef5"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	votes, _, err := client.Votes.Get(context.Background(), "TEST-1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if votes.Votes != 2 || !votes.HasVoted || len(votes.Voters) != 2 {
+		t.Errorf("votes = %+v, want 2 votes including the caller's", votes)
+	}
+}
+
+func TestVotesService_AddRemove(t *testing.T) {
+	tests := []struct {
+		method string
+		call   func(*Client) (*Response, error)
+	}{
+		{http.MethodPost, func(c *Client) (*Response, error) { return c.Votes.Add(context.Background(), "TEST-1") }},
+		{http.MethodDelete, func(c *Client) (*Response, error) { return c.Votes.Remove(context.Background(), "TEST-1") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.method || r.URL.Path != "/rest/api/3/issue/TEST-1/votes" {
+					t.Errorf("request = %v %v, want %v /rest/api/3/issue/TEST-1/votes", r.Method, r.URL.Path, tt.method)
+				}
+				if body, _ := io.ReadAll(r.Body); len(body) != 0 {
+					t.Errorf("body = %q, want empty", body)
+				}
+				if got := r.Header.Get("Content-Type"); got != "" {
+					t.Errorf("Content-Type = %v, want none", got)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			resp, err := tt.call(client)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if resp.StatusCode != http.StatusNoContent {
+				t.Errorf("StatusCode = %v, want %v", resp.StatusCode, http.StatusNoContent)
+			}
+		})
+	}
+}
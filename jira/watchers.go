@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // WatchersService handles watcher operations for the Jira API.
@@ -37,12 +38,31 @@ func (s *WatchersService) Get(ctx context.Context, issueIDOrKey string) (*Watche
 	return watchers, resp, nil
 }
 
-// Add adds a watcher to an issue.
+// Count returns the number of users watching an issue. It fetches only the
+// watches field of the issue rather than the full watcher list.
+func (s *WatchersService) Count(ctx context.Context, issueIDOrKey string) (int, *Response, error) {
+	issue, resp, err := s.client.Issues.Get(ctx, issueIDOrKey, &IssueGetOptions{Fields: []string{"watches"}})
+	if err != nil {
+		return 0, resp, err
+	}
+
+	if issue.Fields == nil || issue.Fields.Watches == nil {
+		return 0, resp, nil
+	}
+	return issue.Fields.Watches.WatchCount, resp, nil
+}
+
+// Add adds a watcher to an issue. An empty accountID adds the current user.
 func (s *WatchersService) Add(ctx context.Context, issueIDOrKey, accountID string) (*Response, error) {
 	u := fmt.Sprintf("/rest/api/3/issue/%s/watchers", issueIDOrKey)
 
-	// The API expects the account ID as a JSON string, not an object
-	req, err := s.client.NewRequest(ctx, http.MethodPost, u, accountID)
+	// The API expects the account ID as a JSON string, not an object, and
+	// watches as the caller when there is no body at all.
+	var body any
+	if accountID != "" {
+		body = accountID
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, body)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +72,7 @@ func (s *WatchersService) Add(ctx context.Context, issueIDOrKey, accountID strin
 
 // Remove removes a watcher from an issue.
 func (s *WatchersService) Remove(ctx context.Context, issueIDOrKey, accountID string) (*Response, error) {
-	u := fmt.Sprintf("/rest/api/3/issue/%s/watchers?accountId=%s", issueIDOrKey, accountID)
+	u := fmt.Sprintf("/rest/api/3/issue/%s/watchers?accountId=%s", issueIDOrKey, url.QueryEscape(accountID))
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
 	if err != nil {
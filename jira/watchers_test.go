@@ -0,0 +1,87 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWatchersService_Count(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/TEST-1" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/issue/TEST-1")
+		}
+		if got := r.URL.Query().Get("fields"); got != "watches" {
+			t.Errorf("fields = %v, want %v", got, "watches")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"key":"TEST-1","fields":{"watches":{"watchCount":3,"isWatching":true}}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	count, _, err := client.Watchers.Count(context.Background(), "TEST-1")
+	if err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Count() = %v, want %v", count, 3)
+	}
+}
+
+func TestWatchersService_Add(t *testing.T) {
+	tests := []struct {
+		name      string
+		accountID string
+		wantBody  string
+	}{
+		{"user", "5b10a2844c20165700ede21g", `"5b10a2844c20165700ede21g"`},
+		{"current user", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/issue/TEST-1/watchers" {
+					t.Errorf("request = %v %v, want POST /rest/api/3/issue/TEST-1/watchers", r.Method, r.URL.Path)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body = %v, want %v", got, tt.wantBody)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			if _, err := client.Watchers.Add(context.Background(), "TEST-1", tt.accountID); err != nil {
+				t.Errorf("Add() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestWatchersService_Remove(t *testing.T) {
+	accountID := "557058:f58131cb-b67d-43c7-b30d-6b58d40bd077"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Method = %v, want %v", r.Method, http.MethodDelete)
+		}
+		if got := r.URL.Query().Get("accountId"); got != accountID {
+			t.Errorf("accountId = %v, want %v", got, accountID)
+		}
+		if r.ContentLength > 0 {
+			t.Errorf("ContentLength = %v, want no body", r.ContentLength)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	if _, err := client.Watchers.Remove(context.Background(), "TEST-1", accountID); err != nil {
+		t.Errorf("Remove() error = %v", err)
+	}
+}
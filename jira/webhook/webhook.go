@@ -0,0 +1,132 @@
+// Package webhook parses the JSON payloads Jira sends to webhooks into typed
+// events built from the jira package's types.
+//
+//	func handle(w http.ResponseWriter, r *http.Request) {
+//		event, err := webhook.ParseWebhook(r)
+//		if err != nil {
+//			http.Error(w, err.Error(), http.StatusBadRequest)
+//			return
+//		}
+//		switch e := event.(type) {
+//		case *webhook.IssueCreated:
+//			log.Printf("%s created %s", e.User.DisplayName, e.Issue.Key)
+//		case *webhook.CommentCreated:
+//			log.Printf("comment %s on %s", e.Comment.ID, e.Issue.Key)
+//		}
+//	}
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aaronmaturen/go-jira/jira"
+)
+
+// Webhook event names, as sent in the webhookEvent field.
+const (
+	EventIssueCreated   = "jira:issue_created"
+	EventIssueUpdated   = "jira:issue_updated"
+	EventCommentCreated = "comment_created"
+	EventWorklogUpdated = "worklog_updated"
+)
+
+// ErrUnknownEvent is returned, wrapped, for a payload whose webhookEvent is
+// not one of the Event* constants. Handlers registered for more events than
+// they care about can check for it with errors.Is and ignore the request.
+var ErrUnknownEvent = errors.New("webhook: unknown event")
+
+// Event is a parsed webhook payload: one of *IssueCreated, *IssueUpdated,
+// *CommentCreated or *WorklogUpdated.
+type Event interface {
+	// Name returns the payload's webhookEvent, one of the Event* constants.
+	Name() string
+}
+
+// Payload holds the fields common to every webhook payload.
+type Payload struct {
+	WebhookEvent string     `json:"webhookEvent"`
+	Timestamp    *jira.Time `json:"timestamp,omitempty"`
+	User         *jira.User `json:"user,omitempty"` // The user who triggered the event, if Jira sent one
+}
+
+// Name implements Event.
+func (p *Payload) Name() string { return p.WebhookEvent }
+
+// IssueCreated is sent when an issue is created.
+type IssueCreated struct {
+	Payload
+	IssueEventTypeName string      `json:"issue_event_type_name,omitempty"`
+	Issue              *jira.Issue `json:"issue,omitempty"`
+}
+
+// IssueUpdated is sent when an issue is edited, transitioned, assigned or
+// otherwise changed. Changelog lists the fields that changed.
+type IssueUpdated struct {
+	Payload
+	IssueEventTypeName string              `json:"issue_event_type_name,omitempty"`
+	Issue              *jira.Issue         `json:"issue,omitempty"`
+	Changelog          *jira.ChangeHistory `json:"changelog,omitempty"`
+}
+
+// CommentCreated is sent when a comment is added to an issue. Issue holds
+// only the issue's ID, key and a few fields such as the summary.
+type CommentCreated struct {
+	Payload
+	Comment *jira.Comment `json:"comment,omitempty"`
+	Issue   *jira.Issue   `json:"issue,omitempty"`
+}
+
+// WorklogUpdated is sent when a worklog is changed. Worklog.IssueID
+// identifies the issue.
+type WorklogUpdated struct {
+	Payload
+	Worklog *jira.Worklog `json:"worklog,omitempty"`
+}
+
+// ParseWebhook reads and parses the webhook payload in r's body. The body is
+// restored afterwards, so callers can still read it, for example to verify a
+// signature over the raw bytes.
+func ParseWebhook(r *http.Request) (Event, error) {
+	if r.Body == nil {
+		return nil, errors.New("webhook: request has no body")
+	}
+	data, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("webhook: read body: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses a webhook payload.
+func Parse(data []byte) (Event, error) {
+	var payload Payload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("webhook: decode payload: %w", err)
+	}
+
+	var event Event
+	switch payload.WebhookEvent {
+	case EventIssueCreated:
+		event = new(IssueCreated)
+	case EventIssueUpdated:
+		event = new(IssueUpdated)
+	case EventCommentCreated:
+		event = new(CommentCreated)
+	case EventWorklogUpdated:
+		event = new(WorklogUpdated)
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownEvent, payload.WebhookEvent)
+	}
+
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("webhook: decode %s payload: %w", payload.WebhookEvent, err)
+	}
+	return event, nil
+}
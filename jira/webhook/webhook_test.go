@@ -0,0 +1,166 @@
+package webhook
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const issueCreatedPayload = `{
+	"timestamp": 1718010000000,
+	"webhookEvent": "jira:issue_created",
+	"issue_event_type_name": "issue_created",
+	"user": {"accountId": "5b10a2844c20165700ede21g", "displayName": "Mia Krystof"},
+	"issue": {
+		"id": "10001",
+		"key": "TEST-1",
+		"fields": {
+			"summary": "Login fails",
+			"issuetype": {"id": "10004", "name": "Bug"},
+			"project": {"id": "10000", "key": "TEST"},
+			"created": "2024-06-10T09:00:00.000+0000"
+		}
+	}
+}`
+
+const issueUpdatedPayload = `{
+	"timestamp": 1718013600000,
+	"webhookEvent": "jira:issue_updated",
+	"issue_event_type_name": "issue_generic",
+	"user": {"accountId": "5b10a2844c20165700ede21g", "displayName": "Mia Krystof"},
+	"issue": {"id": "10001", "key": "TEST-1", "fields": {"summary": "Login fails", "status": {"name": "Done"}}},
+	"changelog": {
+		"id": "10200",
+		"items": [{"field": "status", "fieldtype": "jira", "fieldId": "status", "from": "1", "fromString": "To Do", "to": "10002", "toString": "Done"}]
+	}
+}`
+
+const commentCreatedPayload = `{
+	"timestamp": 1718017200000,
+	"webhookEvent": "comment_created",
+	"comment": {
+		"id": "10300",
+		"author": {"accountId": "5b10a2844c20165700ede21g", "displayName": "Mia Krystof"},
+		"body": "Fixed in build 42",
+		"created": "2024-06-10T11:00:00.000+0000"
+	},
+	"issue": {"id": "10001", "key": "TEST-1", "fields": {"summary": "Login fails"}}
+}`
+
+const worklogUpdatedPayload = `{
+	"timestamp": 1718020800000,
+	"webhookEvent": "worklog_updated",
+	"worklog": {
+		"id": "10400",
+		"issueId": "10001",
+		"author": {"accountId": "5b10a2844c20165700ede21g"},
+		"timeSpent": "2h",
+		"timeSpentSeconds": 7200,
+		"started": "2024-06-10T09:00:00.000+0000"
+	}
+}`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		check   func(t *testing.T, event Event)
+	}{
+		{"IssueCreated", issueCreatedPayload, func(t *testing.T, event Event) {
+			e, ok := event.(*IssueCreated)
+			if !ok {
+				t.Fatalf("event = %T, want *IssueCreated", event)
+			}
+			if e.Issue.Key != "TEST-1" || e.Issue.Fields.Summary != "Login fails" || e.Issue.Fields.Type.Name != "Bug" {
+				t.Errorf("Issue = %+v", e.Issue)
+			}
+			if e.IssueEventTypeName != "issue_created" {
+				t.Errorf("IssueEventTypeName = %v, want %v", e.IssueEventTypeName, "issue_created")
+			}
+			if e.User.DisplayName != "Mia Krystof" {
+				t.Errorf("User = %+v", e.User)
+			}
+			if got := e.Timestamp.UnixMilli(); got != 1718010000000 {
+				t.Errorf("Timestamp = %v, want %v", got, int64(1718010000000))
+			}
+		}},
+		{"IssueUpdated", issueUpdatedPayload, func(t *testing.T, event Event) {
+			e, ok := event.(*IssueUpdated)
+			if !ok {
+				t.Fatalf("event = %T, want *IssueUpdated", event)
+			}
+			if e.Issue.Fields.Status.Name != "Done" {
+				t.Errorf("Status = %+v", e.Issue.Fields.Status)
+			}
+			if e.Changelog.ID != "10200" || len(e.Changelog.Items) != 1 || e.Changelog.Items[0].ToString != "Done" {
+				t.Errorf("Changelog = %+v", e.Changelog)
+			}
+		}},
+		{"CommentCreated", commentCreatedPayload, func(t *testing.T, event Event) {
+			e, ok := event.(*CommentCreated)
+			if !ok {
+				t.Fatalf("event = %T, want *CommentCreated", event)
+			}
+			if e.Comment.ID != "10300" || e.Comment.Body != "Fixed in build 42" || e.Comment.Author.DisplayName != "Mia Krystof" {
+				t.Errorf("Comment = %+v", e.Comment)
+			}
+			if e.Issue.Key != "TEST-1" {
+				t.Errorf("Issue.Key = %v, want %v", e.Issue.Key, "TEST-1")
+			}
+			if e.User != nil {
+				t.Errorf("User = %+v, want nil", e.User)
+			}
+		}},
+		{"WorklogUpdated", worklogUpdatedPayload, func(t *testing.T, event Event) {
+			e, ok := event.(*WorklogUpdated)
+			if !ok {
+				t.Fatalf("event = %T, want *WorklogUpdated", event)
+			}
+			if e.Worklog.ID != "10400" || e.Worklog.IssueID != "10001" || e.Worklog.TimeSpentSeconds != 7200 {
+				t.Errorf("Worklog = %+v", e.Worklog)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := Parse([]byte(tt.payload))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			tt.check(t, event)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	_, err := Parse([]byte(`{"webhookEvent":"jira:version_released","version":{"id":"10000"}}`))
+	if !errors.Is(err, ErrUnknownEvent) {
+		t.Errorf("Parse(unknown event) error = %v, want %v", err, ErrUnknownEvent)
+	}
+	if _, err := Parse([]byte(`{"webhookEvent":`)); err == nil {
+		t.Error("Parse(truncated) error = nil, want error")
+	}
+	if _, err := Parse([]byte(`{"webhookEvent":"jira:issue_created","issue":[]}`)); err == nil {
+		t.Error("Parse(bad issue) error = nil, want error")
+	}
+}
+
+func TestParseWebhook_RestoresBody(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/hooks/jira", strings.NewReader(issueCreatedPayload))
+
+	event, err := ParseWebhook(r)
+	if err != nil {
+		t.Fatalf("ParseWebhook() error = %v", err)
+	}
+	if event.Name() != EventIssueCreated {
+		t.Errorf("Name() = %v, want %v", event.Name(), EventIssueCreated)
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	if string(body) != issueCreatedPayload {
+		t.Errorf("body after ParseWebhook = %q, want the original payload", body)
+	}
+}
@@ -0,0 +1,159 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// WebhooksService handles dynamic webhook registration for Connect and OAuth
+// 2.0 apps. Webhooks registered this way expire after 30 days unless
+// refreshed with Refresh. Use the webhook package to parse the payloads Jira
+// sends.
+type WebhooksService struct {
+	client *Client
+}
+
+// Webhook represents a registered webhook.
+type Webhook struct {
+	ID                      int64    `json:"id,omitempty"`
+	JQLFilter               string   `json:"jqlFilter,omitempty"`
+	FieldIDsFilter          []string `json:"fieldIdsFilter,omitempty"`
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"`
+	Events                  []string `json:"events,omitempty"`
+	ExpirationDate          *Time    `json:"expirationDate,omitempty"`
+}
+
+// WebhookDetails describes one webhook to register. Events are webhook event
+// names such as "jira:issue_created", "jira:issue_updated",
+// "jira:issue_deleted", "comment_created", "comment_updated",
+// "comment_deleted", "issue_property_set" and "issue_property_deleted".
+type WebhookDetails struct {
+	JQLFilter               string   `json:"jqlFilter"`
+	Events                  []string `json:"events"`
+	FieldIDsFilter          []string `json:"fieldIdsFilter,omitempty"`
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"`
+}
+
+// WebhookRegistrationRequest represents a request to register webhooks that
+// all deliver to URL, which must be on the app's base URL.
+type WebhookRegistrationRequest struct {
+	URL      string            `json:"url"`
+	Webhooks []*WebhookDetails `json:"webhooks"`
+}
+
+// WebhookRegistrationResult reports the outcome of a registration, with one
+// entry per requested webhook, in request order.
+type WebhookRegistrationResult struct {
+	Results []*RegisteredWebhook `json:"webhookRegistrationResult,omitempty"`
+}
+
+// RegisteredWebhook is the outcome of registering one webhook: its new ID, or
+// the errors that prevented registering it.
+type RegisteredWebhook struct {
+	CreatedWebhookID int64    `json:"createdWebhookId,omitempty"`
+	Errors           []string `json:"errors,omitempty"`
+}
+
+// WebhookListResult represents a paginated list of webhooks.
+type WebhookListResult struct {
+	StartAt    int        `json:"startAt,omitempty"`
+	MaxResults int        `json:"maxResults,omitempty"`
+	Total      int        `json:"total,omitempty"`
+	IsLast     bool       `json:"isLast,omitempty"`
+	Values     []*Webhook `json:"values,omitempty"`
+}
+
+// WebhookRefreshResult reports the new expiry of refreshed webhooks.
+type WebhookRefreshResult struct {
+	ExpirationDate *Time `json:"expirationDate,omitempty"`
+}
+
+// webhookIDs is the request body of Delete and Refresh.
+type webhookIDs struct {
+	WebhookIDs []int64 `json:"webhookIds"`
+}
+
+// Register registers webhooks for the calling app. A webhook that can't be
+// registered is reported in its result entry's Errors rather than failing
+// the whole request.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-post
+func (s *WebhooksService) Register(ctx context.Context, request *WebhookRegistrationRequest) (*WebhookRegistrationResult, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "/rest/api/3/webhook", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(WebhookRegistrationResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// List returns a page of the webhooks registered by the calling app.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-get
+func (s *WebhooksService) List(ctx context.Context, startAt, maxResults int) (*WebhookListResult, *Response, error) {
+	u := "/rest/api/3/webhook"
+
+	params := url.Values{}
+	if startAt > 0 {
+		params.Set("startAt", strconv.Itoa(startAt))
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", strconv.Itoa(maxResults))
+	}
+	if len(params) > 0 {
+		u = fmt.Sprintf("%s?%s", u, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(WebhookListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// Delete removes webhooks registered by the calling app.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-delete
+func (s *WebhooksService) Delete(ctx context.Context, ids []int64) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, "/rest/api/3/webhook", &webhookIDs{WebhookIDs: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Refresh extends the life of webhooks registered by the calling app, which
+// otherwise expire 30 days after registration or their last refresh.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-refresh-put
+func (s *WebhooksService) Refresh(ctx context.Context, ids []int64) (*WebhookRefreshResult, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, "/rest/api/3/webhook/refresh", &webhookIDs{WebhookIDs: ids})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(WebhookRefreshResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
@@ -0,0 +1,134 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWebhooksService_Register(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Method = %v, want %v", r.Method, http.MethodPost)
+		}
+		if want := "/rest/api/3/webhook"; r.URL.Path != want {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, want)
+		}
+		body, _ := io.ReadAll(r.Body)
+		want := `{"url":"https://app.example.com/hooks","webhooks":[` +
+			`{"jqlFilter":"project = TEST","events":["jira:issue_created","jira:issue_updated"],"fieldIdsFilter":["summary"]},` +
+			`{"jqlFilter":"bad jql (","events":["comment_created"]}]}`
+		if got := strings.TrimSpace(string(body)); got != want {
+			t.Errorf("body = %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"webhookRegistrationResult":[{"createdWebhookId":1000},{"errors":["The JQL query cannot be parsed."]}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, _, err := client.Webhooks.Register(context.Background(), &WebhookRegistrationRequest{
+		URL: "https://app.example.com/hooks",
+		Webhooks: []*WebhookDetails{
+			{JQLFilter: "project = TEST", Events: []string{"jira:issue_created", "jira:issue_updated"}, FieldIDsFilter: []string{"summary"}},
+			{JQLFilter: "bad jql (", Events: []string{"comment_created"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if len(result.Results) != 2 {
+		t.Fatalf("len(Results) = %v, want 2", len(result.Results))
+	}
+	if result.Results[0].CreatedWebhookID != 1000 {
+		t.Errorf("CreatedWebhookID = %v, want %v", result.Results[0].CreatedWebhookID, 1000)
+	}
+	if len(result.Results[1].Errors) != 1 {
+		t.Errorf("Errors = %v, want one error", result.Results[1].Errors)
+	}
+}
+
+func TestWebhooksService_RefreshAndDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(*Client) (*Response, error)
+		wantMethod string
+		wantPath   string
+	}{
+		{
+			name: "Refresh",
+			call: func(c *Client) (*Response, error) {
+				result, resp, err := c.Webhooks.Refresh(context.Background(), []int64{1000, 1001})
+				if err == nil && result.ExpirationDate.UnixMilli() != 1720000000000 {
+					t.Errorf("ExpirationDate = %v, want %v", result.ExpirationDate.UnixMilli(), int64(1720000000000))
+				}
+				return resp, err
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/rest/api/3/webhook/refresh",
+		},
+		{
+			name: "Delete",
+			call: func(c *Client) (*Response, error) {
+				return c.Webhooks.Delete(context.Background(), []int64{1000, 1001})
+			},
+			wantMethod: http.MethodDelete,
+			wantPath:   "/rest/api/3/webhook",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.wantMethod {
+					t.Errorf("Method = %v, want %v", r.Method, tt.wantMethod)
+				}
+				if r.URL.Path != tt.wantPath {
+					t.Errorf("URL path = %v, want %v", r.URL.Path, tt.wantPath)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if got, want := strings.TrimSpace(string(body)), `{"webhookIds":[1000,1001]}`; got != want {
+					t.Errorf("body = %v, want %v", got, want)
+				}
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"expirationDate":1720000000000}`))
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			if _, err := tt.call(client); err != nil {
+				t.Errorf("%s() error = %v", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestWebhooksService_List(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.RawQuery, "maxResults=50&startAt=100"; got != want {
+			t.Errorf("query = %v, want %v", got, want)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"startAt":100,"maxResults":50,"total":101,"isLast":true,"values":[` +
+			`{"id":1000,"jqlFilter":"project = TEST","events":["jira:issue_created"],"expirationDate":1720000000000}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	result, resp, err := client.Webhooks.List(context.Background(), 100, 50)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(result.Values) != 1 || result.Values[0].ID != 1000 || result.Values[0].ExpirationDate == nil {
+		t.Errorf("Values = %+v", result.Values)
+	}
+	if !resp.IsLast {
+		t.Errorf("IsLast = %v, want true", resp.IsLast)
+	}
+}
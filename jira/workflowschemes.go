@@ -158,6 +158,28 @@ func (s *WorkflowSchemesService) Delete(ctx context.Context, schemeID int64) (*R
 	return s.client.Do(req, nil)
 }
 
+// AssignToProject assigns a workflow scheme to a project. A schemeID of zero
+// assigns the default workflow scheme. Team-managed projects don't use
+// workflow schemes, so for those it returns a *TeamManagedProjectError
+// without making the change.
+func (s *WorkflowSchemesService) AssignToProject(ctx context.Context, schemeID int64, projectID string) (*Response, error) {
+	if resp, err := s.client.Projects.requireCompanyManaged(ctx, projectID, "workflow scheme assignment"); err != nil {
+		return resp, err
+	}
+
+	body := map[string]interface{}{"projectId": projectID}
+	if schemeID != 0 {
+		body["workflowSchemeId"] = strconv.FormatInt(schemeID, 10)
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, "/rest/api/3/workflowscheme/project", body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // GetDefault returns the default workflow for a scheme.
 func (s *WorkflowSchemesService) GetDefault(ctx context.Context, schemeID int64, returnDraftIfExists bool) (*DefaultWorkflow, *Response, error) {
 	u := fmt.Sprintf("/rest/api/3/workflowscheme/%d/default", schemeID)
@@ -303,7 +303,8 @@ func (s *WorklogsService) ListDeleted(ctx context.Context, since int64) (*Worklo
 	return result, resp, nil
 }
 
-// GetByIDs returns worklogs by their IDs.
+// GetByIDs returns worklogs by their IDs. Jira accepts at most 1000 IDs per
+// request; use GetAllByIDs for longer lists.
 func (s *WorklogsService) GetByIDs(ctx context.Context, ids []int64, expand []string) ([]*Worklog, *Response, error) {
 	u := "/rest/api/3/worklog/list"
 
@@ -325,6 +326,33 @@ func (s *WorklogsService) GetByIDs(ctx context.Context, ids []int64, expand []st
 	return worklogs, resp, nil
 }
 
+// maxWorklogIDs is the number of worklog IDs GetByIDs accepts per request.
+const maxWorklogIDs = 1000
+
+// GetAllByIDs returns the worklogs with the given IDs, fetching them with
+// GetByIDs in sequential batches of 1000. The returned Response is that of the
+// last batch; it is nil if ids is empty. On error it returns the worklogs
+// fetched so far.
+func (s *WorklogsService) GetAllByIDs(ctx context.Context, ids []int64, expand []string) ([]*Worklog, *Response, error) {
+	var (
+		worklogs []*Worklog
+		resp     *Response
+	)
+	for start := 0; start < len(ids); start += maxWorklogIDs {
+		end := min(start+maxWorklogIDs, len(ids))
+
+		var batch []*Worklog
+		var err error
+		batch, resp, err = s.GetByIDs(ctx, ids[start:end], expand)
+		if err != nil {
+			return worklogs, resp, err
+		}
+		worklogs = append(worklogs, batch...)
+	}
+
+	return worklogs, resp, nil
+}
+
 // GetPropertyKeys returns property keys for a worklog.
 func (s *WorklogsService) GetPropertyKeys(ctx context.Context, issueIDOrKey, worklogID string) ([]string, *Response, error) {
 	u := fmt.Sprintf("/rest/api/3/issue/%s/worklog/%s/properties", issueIDOrKey, worklogID)
@@ -393,3 +421,44 @@ func (s *WorklogsService) DeleteProperty(ctx context.Context, issueIDOrKey, work
 
 	return s.client.Do(req, nil)
 }
+
+// maxWorklogMove is the number of worklogs Jira moves in one request.
+const maxWorklogMove = 5000
+
+// Move moves worklogs from one issue to another, keeping their author, start
+// time and other metadata. At most 5000 worklogs can be moved per call.
+//
+// Jira performs the move synchronously: a 204 response means every worklog was
+// moved, while a 200 response means only some were. Worklogs with attachments
+// or restricted by project role can't be moved, and no notifications, webhooks
+// or issue history are produced for the move.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-worklogs/#api-rest-api-3-issue-issueidorkey-worklog-move-post
+func (s *WorklogsService) Move(ctx context.Context, fromIssue string, worklogIDs []string, toIssue string) (*Response, error) {
+	if len(worklogIDs) > maxWorklogMove {
+		return nil, fmt.Errorf("cannot move %d worklogs: at most %d are allowed per request", len(worklogIDs), maxWorklogMove)
+	}
+
+	ids := make([]int64, len(worklogIDs))
+	for i, id := range worklogIDs {
+		n, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid worklog ID %q: %w", id, err)
+		}
+		ids[i] = n
+	}
+
+	u := fmt.Sprintf("/rest/api/3/issue/%s/worklog/move", fromIssue)
+
+	body := map[string]any{
+		"ids":          ids,
+		"issueIdOrKey": toIssue,
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
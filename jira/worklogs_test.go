@@ -0,0 +1,115 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWorklogsService_GetAllByIDs(t *testing.T) {
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Method = %v, want %v", r.Method, http.MethodPost)
+		}
+		if want := "/rest/api/3/worklog/list"; r.URL.Path != want {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, want)
+		}
+		if got, want := r.URL.Query().Get("expand"), "properties"; got != want {
+			t.Errorf("expand = %v, want %v", got, want)
+		}
+		var body struct {
+			IDs []int64 `json:"ids"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		batches = append(batches, len(body.IDs))
+
+		worklogs := make([]*Worklog, len(body.IDs))
+		for i, id := range body.IDs {
+			worklogs[i] = &Worklog{ID: strconv.FormatInt(id, 10)}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(worklogs)
+	}))
+	defer server.Close()
+
+	ids := make([]int64, 1500)
+	for i := range ids {
+		ids[i] = int64(10000 + i)
+	}
+
+	client, _ := NewClient(server.URL)
+	worklogs, resp, err := client.Worklogs.GetAllByIDs(context.Background(), ids, []string{"properties"})
+	if err != nil {
+		t.Fatalf("GetAllByIDs() error = %v", err)
+	}
+	if resp == nil {
+		t.Error("GetAllByIDs() response = nil, want the last batch's response")
+	}
+	if len(batches) != 2 || batches[0] != 1000 || batches[1] != 500 {
+		t.Errorf("batch sizes = %v, want [1000 500]", batches)
+	}
+	if len(worklogs) != 1500 || worklogs[0].ID != "10000" || worklogs[1499].ID != "11499" {
+		t.Errorf("GetAllByIDs() returned %d worklogs, want 1500 in request order", len(worklogs))
+	}
+}
+
+func TestWorklogsService_Move(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Method = %v, want %v", r.Method, http.MethodPost)
+		}
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/worklog/move" {
+			t.Errorf("URL path = %v, want %v", r.URL.Path, "/rest/api/3/issue/TEST-1/worklog/move")
+		}
+		body, _ := io.ReadAll(r.Body)
+		want := `{"ids":[10000,10001],"issueIdOrKey":"TEST-2"}`
+		if got := strings.TrimSpace(string(body)); got != want {
+			t.Errorf("body = %v, want %v", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(server.URL)
+	if _, err := client.Worklogs.Move(context.Background(), "TEST-1", []string{"10000", "10001"}, "TEST-2"); err != nil {
+		t.Fatalf("Move() error = %v", err)
+	}
+}
+
+func TestWorklogsService_Move_Invalid(t *testing.T) {
+	tooMany := make([]string, maxWorklogMove+1)
+	for i := range tooMany {
+		tooMany[i] = strconv.Itoa(10000 + i)
+	}
+	tests := []struct {
+		name string
+		ids  []string
+	}{
+		{"too many", tooMany},
+		{"non-numeric", []string{"10000", "abc"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(server.URL)
+			if _, err := client.Worklogs.Move(context.Background(), "TEST-1", tt.ids, "TEST-2"); err == nil {
+				t.Error("Move() expected error")
+			}
+			if requests != 0 {
+				t.Errorf("requests = %v, want 0", requests)
+			}
+		})
+	}
+}